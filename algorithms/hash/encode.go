@@ -0,0 +1,210 @@
+package hash
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+)
+
+/*
+Canonical encoding of any value into bytes for hashing. It replaces encoding/gob, which writes type descriptors,
+allocates a new encoder for every value and rejects channels.
+
+Two values which are equal by reflect.DeepEqual (the equality of the default HashMap Hasher) always get identical bytes:
+  - Booleans and numbers have a fixed little-endian layout. int and uint always have 8 bytes, so that the bytes are the
+    same on every platform. -0 and +0 get the same bytes, and so does every NaN.
+  - Strings, slices and maps are prefixed with their length, so that ("ab", "c") and ("a", "bc") get different bytes.
+  - Arrays and structs are encoded element by element and field by field, also unexported fields.
+  - Pointers and interfaces start with a byte telling whether they are nil. Pointers are followed by the value they point to,
+    interfaces by the name of their dynamic type and their dynamic value.
+  - Maps are equal regardless of their order, so their pairs are encoded separately and sorted.
+  - Channels are only equal to themselves, so their address is encoded.
+  - Types implementing Hashable supply their own bytes.
+
+Functions are only equal if both are nil, so a HashMap could never find a non-nil function key again.
+Encoding them returns ErrUnsupportedType.
+
+Comparable keys are compared with == instead, which compares pointers by their address and not by the value they point to.
+For them, convertComparable encodes pointers by their address, so that the hash does not change when the value they point to changes.
+*/
+
+// Hashable can be implemented by types which supply their own bytes for hashing instead of the canonical encoding.
+//
+// Two equal values must always return identical bytes.
+type Hashable interface {
+	HashBytes() []byte
+}
+
+var ErrUnsupportedType = errors.New("hash: type can not be encoded")
+
+// maxEncodeDepth limits the nesting of pointers, so that cyclic values return an error instead of recursing forever.
+const maxEncodeDepth = 100
+
+var hashableType = reflect.TypeFor[Hashable]()
+
+// convertToByteArray encodes data canonically, so that equal values always have identical bytes. Runtime O(n)
+func convertToByteArray(data any) ([]byte, error) {
+	return appendEncoded(make([]byte, 0, 64), reflect.ValueOf(data), 0, false)
+}
+
+// convertComparable encodes data like convertToByteArray, but pointers by their address, so that values which are
+// equal by == always have identical bytes. Runtime O(n)
+func convertComparable(data any) ([]byte, error) {
+	return appendEncoded(make([]byte, 0, 64), reflect.ValueOf(data), 0, true)
+}
+
+// appendEncoded appends the canonical encoding of v to buf. If byAddress is set, pointers are encoded by their address.
+func appendEncoded(buf []byte, v reflect.Value, depth int, byAddress bool) ([]byte, error) {
+	if !v.IsValid() {
+		// nil any
+		return append(buf, 0), nil
+	}
+	if depth > maxEncodeDepth {
+		return buf, fmt.Errorf("%w: %v is nested too deeply or cyclic", ErrUnsupportedType, v.Type())
+	}
+
+	if byAddress && v.Kind() == reflect.Pointer {
+		// The pointer is equal to itself even if the value it points to changes, and the value may even contain the pointer.
+		return binary.LittleEndian.AppendUint64(buf, uint64(v.Pointer())), nil
+	}
+
+	if v.Type().Implements(hashableType) && v.CanInterface() {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return append(buf, 0), nil
+		}
+		b := v.Interface().(Hashable).HashBytes()
+		buf = binary.AppendUvarint(buf, uint64(len(b)))
+		return append(buf, b...), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+	case reflect.Int8:
+		return append(buf, byte(v.Int())), nil
+	case reflect.Int16:
+		return binary.LittleEndian.AppendUint16(buf, uint16(v.Int())), nil
+	case reflect.Int32:
+		return binary.LittleEndian.AppendUint32(buf, uint32(v.Int())), nil
+	case reflect.Int, reflect.Int64:
+		return binary.LittleEndian.AppendUint64(buf, uint64(v.Int())), nil
+	case reflect.Uint8:
+		return append(buf, byte(v.Uint())), nil
+	case reflect.Uint16:
+		return binary.LittleEndian.AppendUint16(buf, uint16(v.Uint())), nil
+	case reflect.Uint32:
+		return binary.LittleEndian.AppendUint32(buf, uint32(v.Uint())), nil
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return binary.LittleEndian.AppendUint64(buf, v.Uint()), nil
+	case reflect.Float32:
+		return binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(canonicalFloat(v.Float())))), nil
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(canonicalFloat(v.Float()))), nil
+	case reflect.Complex64:
+		c := v.Complex()
+		buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(canonicalFloat(real(c)))))
+		return binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(canonicalFloat(imag(c))))), nil
+	case reflect.Complex128:
+		c := v.Complex()
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(canonicalFloat(real(c))))
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(canonicalFloat(imag(c)))), nil
+	case reflect.String:
+		buf = binary.AppendUvarint(buf, uint64(v.Len()))
+		return append(buf, v.String()...), nil
+	case reflect.Slice:
+		buf = binary.AppendUvarint(buf, uint64(v.Len()))
+		if v.Type().Elem().Kind() == reflect.Uint8 && !v.Type().Elem().Implements(hashableType) {
+			return append(buf, v.Bytes()...), nil
+		}
+		return appendElems(buf, v, depth, byAddress)
+	case reflect.Array:
+		return appendElems(buf, v, depth, byAddress)
+	case reflect.Struct:
+		var err error
+		for i := 0; i < v.NumField() && err == nil; i++ {
+			// Blank fields are ignored when comparing structs.
+			if v.Type().Field(i).Name != "_" {
+				buf, err = appendEncoded(buf, v.Field(i), depth, byAddress)
+			}
+		}
+		return buf, err
+	case reflect.Pointer:
+		if v.IsNil() {
+			return append(buf, 0), nil
+		}
+		return appendEncoded(append(buf, 1), v.Elem(), depth+1, byAddress)
+	case reflect.Interface:
+		if v.IsNil() {
+			return append(buf, 0), nil
+		}
+		// int(1) and int64(1) are not equal, so the dynamic type is part of the encoding.
+		name := v.Elem().Type().String()
+		buf = binary.AppendUvarint(append(buf, 1), uint64(len(name)))
+		return appendEncoded(append(buf, name...), v.Elem(), depth+1, byAddress)
+	case reflect.Map:
+		return appendMap(buf, v, depth, byAddress)
+	case reflect.Chan, reflect.UnsafePointer:
+		return binary.LittleEndian.AppendUint64(buf, uint64(v.Pointer())), nil
+	case reflect.Func:
+		if v.IsNil() {
+			return append(buf, 0), nil
+		}
+	}
+
+	return buf, fmt.Errorf("%w: %v", ErrUnsupportedType, v.Type())
+}
+
+// appendElems appends the canonical encoding of every element of the slice or array v to buf.
+func appendElems(buf []byte, v reflect.Value, depth int, byAddress bool) ([]byte, error) {
+	var err error
+	for i := 0; i < v.Len() && err == nil; i++ {
+		buf, err = appendEncoded(buf, v.Index(i), depth, byAddress)
+	}
+	return buf, err
+}
+
+// appendMap appends the length of the map v and its sorted pairs to buf. Runtime O(n log(n))
+func appendMap(buf []byte, v reflect.Value, depth int, byAddress bool) ([]byte, error) {
+	if v.IsNil() {
+		// A nil map and an empty map are not equal.
+		return append(buf, 0), nil
+	}
+
+	pairs := make([][]byte, 0, v.Len())
+	for it := v.MapRange(); it.Next(); {
+		pair, err := appendEncoded(nil, it.Key(), depth+1, byAddress)
+		if err != nil {
+			return buf, err
+		}
+		if pair, err = appendEncoded(pair, it.Value(), depth+1, byAddress); err != nil {
+			return buf, err
+		}
+		pairs = append(pairs, pair)
+	}
+	// Every key exists only once, so the encoded pairs are unique and the sorted order is canonical.
+	slices.SortFunc(pairs, bytes.Compare)
+
+	buf = binary.AppendUvarint(append(buf, 1), uint64(len(pairs)))
+	for _, pair := range pairs {
+		buf = append(buf, pair...)
+	}
+	return buf, nil
+}
+
+// canonicalFloat turns -0 into +0 and every NaN into the same NaN.
+func canonicalFloat(f float64) float64 {
+	if f == 0 {
+		return 0
+	}
+	if math.IsNaN(f) {
+		return math.NaN()
+	}
+	return f
+}
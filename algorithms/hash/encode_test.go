@@ -0,0 +1,195 @@
+package hash
+
+import (
+	"bytes"
+	"dsa/util/sugar"
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type encodeInner struct {
+	Name  string
+	Tags  []string
+	Score float64
+}
+
+// encodeKey covers every kind the encoder supports.
+type encodeKey struct {
+	A int
+	B int8
+	C uint16
+	D bool
+	E string
+	F []int
+	G [3]uint32
+	H *encodeInner
+	I any
+	M map[string]int
+	_ int
+	n complex128
+}
+
+// genKey generates a random encodeKey. Keys generated from equal seeds are equal, but share no memory.
+//
+// reverse fills the map in reverse order, so that equal maps are built differently.
+func genKey(rnd *rand.Rand, reverse bool) encodeKey {
+	str := func() string {
+		b := make([]byte, rnd.Intn(6))
+		for i := range b {
+			b[i] = byte('a' + rnd.Intn(3))
+		}
+		return string(b)
+	}
+
+	k := encodeKey{
+		A: rnd.Intn(5) - 2,
+		B: int8(rnd.Intn(256)),
+		C: uint16(rnd.Intn(3)),
+		D: rnd.Intn(2) == 0,
+		E: str(),
+		G: [3]uint32{uint32(rnd.Intn(2)), uint32(rnd.Intn(2)), rnd.Uint32()},
+		n: complex(float64(rnd.Intn(2)), -0.0),
+	}
+	for range rnd.Intn(4) {
+		k.F = append(k.F, rnd.Intn(3))
+	}
+	if rnd.Intn(2) == 0 {
+		k.H = &encodeInner{Name: str(), Score: float64(rnd.Intn(3)) * 0.5}
+		for range rnd.Intn(3) {
+			k.H.Tags = append(k.H.Tags, str())
+		}
+	}
+	switch rnd.Intn(4) {
+	case 0:
+		k.I = rnd.Intn(2)
+	case 1:
+		k.I = int64(rnd.Intn(2))
+	case 2:
+		k.I = str()
+	}
+
+	keys := make([]string, rnd.Intn(4))
+	for i := range keys {
+		keys[i] = str()
+	}
+	vals := make([]int, len(keys))
+	for i := range vals {
+		vals[i] = rnd.Intn(3)
+	}
+	if len(keys) > 0 {
+		k.M = make(map[string]int)
+	}
+	for i := range keys {
+		j := i
+		if reverse {
+			j = len(keys) - 1 - i
+		}
+		// The last duplicate key wins. In reverse, it comes first, so it must not be overwritten.
+		if _, ok := k.M[keys[j]]; !ok || !reverse {
+			k.M[keys[j]] = vals[j]
+		}
+	}
+	return k
+}
+
+func TestConvertToByteArray_EqualValues(t *testing.T) {
+	name := "generated equal values have identical bytes"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		// Bytes of unequal values, to find values which are wrongly encoded the same.
+		seen := make(map[string]encodeKey)
+		for i := range 5000 {
+			a := genKey(rand.New(rand.NewSource(int64(i))), false)
+			b := genKey(rand.New(rand.NewSource(int64(i))), true)
+			if !reflect.DeepEqual(a, b) {
+				t.Fatalf("seed %v: generated values are not equal: %+v, %+v", i, a, b)
+			}
+
+			encA, errA := convertToByteArray(a)
+			encB, errB := convertToByteArray(b)
+			if errA != nil || errB != nil {
+				t.Fatalf("seed %v: convertToByteArray() threw error: %v, %v", i, errA, errB)
+			}
+			if !bytes.Equal(encA, encB) {
+				t.Fatalf("seed %v: equal values have different bytes:\n%+v: %x\n%+v: %x", i, a, encA, b, encB)
+			}
+
+			if other, found := seen[string(encA)]; found && !reflect.DeepEqual(other, a) {
+				t.Fatalf("seed %v: different values have identical bytes: %+v, %+v", i, other, a)
+			}
+			seen[string(encA)] = a
+		}
+	})
+}
+
+// lowerCase supplies its own bytes, so that it is hashed case-insensitively.
+type lowerCase string
+
+func (l lowerCase) HashBytes() []byte {
+	return []byte(strings.ToLower(string(l)))
+}
+
+func TestConvertToByteArray(t *testing.T) {
+	nan := math.NaN()
+	type testCase struct {
+		name      string
+		a, b      any
+		wantEqual bool
+	}
+	tests := []testCase{
+		{"-0 and +0", 0.0, math.Copysign(0, -1), true},
+		{"-0 and +0 float32", float32(0), float32(math.Copysign(0, -1)), true},
+		{"NaNs", nan, -nan, true},
+		{"int has 8 bytes like int64", int(-1), int64(-1), true},
+		{"int and int64 in any", []any{int(1)}, []any{int64(1)}, false},
+		{"string boundaries", [2]string{"ab", "c"}, [2]string{"a", "bc"}, false},
+		{"slice boundaries", [2][]int{{1, 2}, {3}}, [2][]int{{1}, {2, 3}}, false},
+		{"nil and non-nil pointer to zero", (*int)(nil), new(int), false},
+		{"different pointers to equal values", &[]int{1}, &[]int{1}, true},
+		{"nil any in slice", []any{nil}, []any{0}, false},
+		{"maps in any order", map[int]string{1: "a", 2: "b", 3: "c"}, map[int]string{3: "c", 2: "b", 1: "a"}, true},
+		{"nil and empty map", map[int]int(nil), map[int]int{}, false},
+		{"maps of different size", map[int]int{1: 1}, map[int]int{1: 1, 2: 2}, false},
+		{"Hashable", lowerCase("Go"), lowerCase("GO"), true},
+		{"Hashable in struct", struct{ L lowerCase }{"Go"}, struct{ L lowerCase }{"gO"}, true},
+		{"Hashable differs", lowerCase("Go"), lowerCase("Rust"), false},
+		{"same channel", encodeChan, encodeChan, true},
+		{"different channels", encodeChan, make(chan int), false},
+		{"nil func", (func())(nil), (func())(nil), true},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			a, errA := convertToByteArray(tt.a)
+			b, errB := convertToByteArray(tt.b)
+			if errA != nil || errB != nil {
+				t.Errorf("convertToByteArray() threw error: %v, %v", errA, errB)
+			}
+			if bytes.Equal(a, b) != tt.wantEqual {
+				t.Errorf("convertToByteArray() a = %x, b = %x, wantEqual %v", a, b, tt.wantEqual)
+			}
+		})
+	}
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		type cyclic struct {
+			Next *cyclic
+		}
+		c := &cyclic{}
+		c.Next = c
+		for _, in := range []any{func() {}, []func(){func() {}}, map[int]func(){1: func() {}}, c} {
+			if _, err := convertToByteArray(in); !errors.Is(err, ErrUnsupportedType) {
+				t.Errorf("convertToByteArray(%T) error = %v, want %v", in, err, ErrUnsupportedType)
+			}
+		}
+	})
+}
+
+var encodeChan = make(chan int)
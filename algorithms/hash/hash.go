@@ -1,97 +1,129 @@
 package hash
 
 import (
-	"bytes"
 	"encoding/binary"
-	"encoding/gob"
 )
 
 /* NOTE:
-These algorithms are all written by AI for me to study and slightly adjusted (mainly the conversion to a byte array)
+These algorithms were first written by AI for me to study and slightly adjusted (mainly the conversion to a byte array)
 and some comments from my side.
-It's hard for me to confirm if they are correct at the moment,
-since actual implementations are often way more complicated
-because of interface contract reasons, security and other unkown reasons.
-And also my current lack of fully understanding the papers for these hashing algorithms.
 
-Online converters are giving different results for reasons I do not fully understand. Perhaps because they are taking strings
-as input, whereas I am converting any input into a []byte to be able to use any data type as input,
-or also because of different undisclosed prime numbers and seeds. 
+The byte-oriented entry points DJB2Bytes, Murmur3_32 and XXH64 match the reference implementations and are tested
+against their published test vectors. XxHash used to be a simple multiply-add loop, now it is the real xxHash64.
 
-For this reason, I have not written any tests.
+Online converters are still giving different results for DJB2, XxHash and Murmur3, because those take any input
+and encode it canonically into a []byte first (see encode.go), so that any data type can be used as input (e.g. as HashMap key).
+Online converters hash the bytes of the string directly. Hash the bytes with the entry points above to get the same results.
 
-
-However, the results provided are pretty good and definetly usable to proceed with implementing a hash-map.
 Especially the Murmur3 implementation seems to have very good distribution when I tested it, so I use it for my hash-map implementation.
-
-I will revisit hashing algorithms at a later point.
 */
 
-// DJB2 This implementation looks to be correct to my knowledge, but online converters yield different results, probably due to my input to []byte conversion
+// DJB2 calculates DJB2Bytes of the canonically encoded input.
 func DJB2(input any) (uint32, error) {
 	in, err := convertToByteArray(input)
 	if err != nil {
 		return 0, err
 	}
 
+	return DJB2Bytes(in), nil
+}
+
+// DJB2Bytes calculates the DJB2 hash by Daniel J. Bernstein of data. Runtime O(n)
+//
+// It is not called DJB2, because DJB2 takes any input for the HashMap Hashers.
+func DJB2Bytes(data []byte) uint32 {
 	var hash uint32 = 5381
-	for i := 0; i < len(in); i++ {
+	for i := 0; i < len(data); i++ {
 		// ((hash << 5) + hash) is simply the same as hash * 33. But with the bit shift, it is faster on many cpus.
-		hash = ((hash << 5) + hash) + uint32(in[i])
+		hash = ((hash << 5) + hash) + uint32(data[i])
 	}
 
-	return hash, nil
+	return hash
 }
 
+// XxHash calculates XXH64 of the canonically encoded input with seed 0.
 func XxHash(input any) (uint64, error) {
 	in, err := convertToByteArray(input)
 	if err != nil {
 		return 0, err
 	}
 
-	prime := uint64(11400714785074694791)
-	var hash uint64 = 0
+	return XXH64(in, 0), nil
+}
 
-	for i := 0; i < len(in); i++ {
-		hash = hash*prime + uint64(in[i])
+// Murmur3 calculates Murmur3_32 of the canonically encoded input. Non-nil funcs are not supported.
+func Murmur3(input any, seed uint32) (uint32, error) {
+	in, err := convertToByteArray(input)
+	if err != nil {
+		return 0, err
 	}
 
-	return hash, nil
+	return murmur3(in, seed), nil
 }
 
-// Murmur3 should correctly calculate a hash for almost all types. One exception is type chan (any) which is not supported.
-func Murmur3(input any, seed uint32) (uint32, error) {
-	in, err := convertToByteArray(input)
+// Murmur3_32 calculates the MurmurHash3 x86_32 by Austin Appleby of data with seed. Runtime O(n)
+//
+// The results match the reference implementation.
+func Murmur3_32(data []byte, seed uint32) uint32 {
+	return murmur3(data, seed)
+}
+
+// Murmur3Fast calculates the same Murmur3 hash for comparable keys, but reads ints, strings, byte slices and fixed-size structs
+// directly from their memory representation instead of encoding them into a fresh buffer.
+// All other types are encoded like for Murmur3, except that pointers are hashed by their address like == compares them.
+//
+// Note: The results differ from Murmur3 for the same input, because the bytes being hashed are different.
+func Murmur3Fast[K any](key K, seed uint32) (uint32, error) {
+	var buf [8]byte
+	if in, ok := memoryBytes(&key, &buf); ok {
+		return murmur3(in, seed), nil
+	}
+	in, err := convertComparable(key)
 	if err != nil {
 		return 0, err
 	}
+	return murmur3(in, seed), nil
+}
 
-	const (
-		c1 = 0xcc9e2d51
-		c2 = 0x1b873593
-		r1 = 15
-		r2 = 13
-		m  = 5
-		n  = 0xe6546b64
-	)
+const (
+	murmurC1 = 0xcc9e2d51
+	murmurC2 = 0x1b873593
+	murmurR1 = 15
+	murmurR2 = 13
+	murmurM  = 5
+	murmurN  = 0xe6546b64
+)
+
+// murmur3 is the MurmurHash3 x86_32 core working directly on bytes.
+func murmur3(in []byte, seed uint32) uint32 {
+	hash, tail := murmur3Blocks(seed, in)
+	return murmur3Finalize(hash, tail, uint32(len(in)))
+}
 
-	hash := seed
+// murmur3Blocks mixes every full block of 4 bytes of in into hash.
+//
+// Returns the new hash and the remaining 0-3 bytes.
+func murmur3Blocks(hash uint32, in []byte) (uint32, []byte) {
 	nblocks := len(in) / 4
 
 	for i := 0; i < nblocks; i++ {
 		k := binary.LittleEndian.Uint32(in[i*4 : (i+1)*4])
-		k *= c1
-		k = (k << r1) | (k >> (32 - r1))
-		k *= c2
+		k *= murmurC1
+		k = (k << murmurR1) | (k >> (32 - murmurR1))
+		k *= murmurC2
 
 		hash ^= k
-		hash = ((hash<<r2)|(hash>>(32-r2)))*m + n
+		hash = ((hash<<murmurR2)|(hash>>(32-murmurR2)))*murmurM + murmurN
 	}
 
-	tail := in[nblocks*4:]
+	return hash, in[nblocks*4:]
+}
+
+// murmur3Finalize mixes the remaining 0-3 bytes of tail and the length of all input into hash and avalanches it.
+func murmur3Finalize(hash uint32, tail []byte, length uint32) uint32 {
 	k1 := uint32(0)
 
-	switch len(in) & 3 {
+	switch len(tail) {
 	case 3:
 		k1 ^= uint32(tail[2]) << 16
 		fallthrough
@@ -100,28 +132,18 @@ func Murmur3(input any, seed uint32) (uint32, error) {
 		fallthrough
 	case 1:
 		k1 ^= uint32(tail[0])
-		k1 *= c1
-		k1 = (k1 << r1) | (k1 >> (32 - r1))
-		k1 *= c2
+		k1 *= murmurC1
+		k1 = (k1 << murmurR1) | (k1 >> (32 - murmurR1))
+		k1 *= murmurC2
 		hash ^= k1
 	}
 
-	hash ^= uint32(len(in))
+	hash ^= length
 	hash ^= hash >> 16
 	hash *= 0x85ebca6b
 	hash ^= hash >> 13
 	hash *= 0xc2b2ae35
 	hash ^= hash >> 16
 
-	return hash, nil
-}
-
-func convertToByteArray(data any) ([]byte, error) {
-	var buf bytes.Buffer
-	encoder := gob.NewEncoder(&buf)
-	err := encoder.Encode(data)
-	if err != nil {
-		return make([]byte, 0), err
-	}
-	return buf.Bytes(), nil
+	return hash
 }
@@ -1,34 +1,256 @@
 package hash
 
-// TODO: revisit when re-attempting to correctly implement hashing algorithms.
+import (
+	"dsa/util/sugar"
+	"errors"
+	"math"
+	"testing"
+)
 
-//func TestDJB2(t *testing.T) {
-//	type args struct {
-//		data any
-//	}
-//	tests := []struct {
-//		name string
-//		args args
-//		want uint32
-//	}{
-//		{"hash test", args{"test"}, 123},
-//		{"hash abc", args{"abc"}, 123},
-//		{"hash 123", args{"123"}, 123},
-//		{"hash äöü", args{"äöü"}, 123},
-//		{"hash i(123)", args{123}, 123},
-//		{"hash i(124)", args{124}, 123},
-//		{"hash i(125)", args{125}, 123},
-//		{"hash i(126)", args{126}, 123},
-//		{"hash i(127)", args{127}, 123},
-//	}
-//	for _, tt := range tests {
-//		startT := time.Now()
-//		t.Run(tt.name, func(t *testing.T) {
-//			if got, _ := DJB2(tt.args.data); got != tt.want {
-//				t.Errorf("Murmur2() = %v, want %v", got, tt.want)
-//			}
-//		})
-//		perf.TimeTracker(startT, tt.name)
-//		perf.PrintMemUsage(perf.KB, tt.name)
-//	}
-//}
+func TestDJB2Bytes(t *testing.T) {
+	// Reference values of the original hash * 33 + c from Daniel J. Bernstein.
+	type testCase struct {
+		name string
+		data string
+		want uint32
+	}
+	tests := []testCase{
+		{"empty", "", 5381},
+		{"a", "a", 177670},
+		{"abc", "abc", 193485963},
+		{"hello", "hello", 261238937},
+		{"overflow", "The quick brown fox jumps over the lazy dog", 0x34cc38de},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			if got := DJB2Bytes([]byte(tt.data)); got != tt.want {
+				t.Errorf("DJB2Bytes() = %#x, want %#x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMurmur3_32(t *testing.T) {
+	// Test vectors of the MurmurHash3_x86_32 reference implementation from SMHasher.
+	type testCase struct {
+		name string
+		data []byte
+		seed uint32
+		want uint32
+	}
+	tests := []testCase{
+		{"empty", []byte{}, 0, 0},
+		{"empty seed 1", []byte{}, 1, 0x514e28b7},
+		{"empty max seed", []byte{}, 0xffffffff, 0x81f16f39},
+		{"zeros", []byte{0, 0, 0, 0}, 0, 0x2362f9de},
+		{"ones", []byte{0xff, 0xff, 0xff, 0xff}, 0, 0x76293b50},
+		{"block with seed", []byte{0x21, 0x43, 0x65, 0x87}, 0x5082edee, 0x2362f9de},
+		{"tail 3", []byte{0x21, 0x43, 0x65}, 0, 0x7e4a8634},
+		{"tail 2", []byte{0x21, 0x43}, 0, 0xa0f7b07a},
+		{"tail 1", []byte{0x21}, 0, 0x72661cf4},
+		{"abc", []byte("abc"), 0, 0xb3dd93fa},
+		{"fox", []byte("The quick brown fox jumps over the lazy dog"), 0, 0x2e4ff723},
+		{"fox with seed", []byte("The quick brown fox jumps over the lazy dog"), 0x9747b28c, 0x2fa826cd},
+		{"hello with seed", []byte("Hello, world!"), 0x9747b28c, 0x24884cba},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			if got := Murmur3_32(tt.data, tt.seed); got != tt.want {
+				t.Errorf("Murmur3_32() = %#x, want %#x", got, tt.want)
+			}
+		})
+	}
+}
+
+// xxhSanityBuffer returns the first n bytes of the sanity check buffer of the xxHash reference implementation (xxhsum).
+func xxhSanityBuffer(n int) []byte {
+	const prime32 = 2654435761
+	const prime64 = 11400714785074694797
+	buf := make([]byte, n)
+	var byteGen uint64 = prime32
+	for i := range buf {
+		buf[i] = byte(byteGen >> 56)
+		byteGen *= prime64
+	}
+	return buf
+}
+
+func TestXXH64(t *testing.T) {
+	// Sanity check vectors of xxhsum and published hashes of strings.
+	const prime32 = 2654435761
+	type testCase struct {
+		name string
+		data []byte
+		seed uint64
+		want uint64
+	}
+	tests := []testCase{
+		{"empty", xxhSanityBuffer(0), 0, 0xef46db3751d8e999},
+		{"empty with seed", xxhSanityBuffer(0), prime32, 0xac75fda2929b17ef},
+		{"1 byte", xxhSanityBuffer(1), 0, 0xe934a84adb052768},
+		{"1 byte with seed", xxhSanityBuffer(1), prime32, 0x5014607643a9b4c3},
+		{"14 bytes", xxhSanityBuffer(14), 0, 0x8282dcc4994e35c8},
+		{"14 bytes with seed", xxhSanityBuffer(14), prime32, 0xc3bd6bf63deb6df0},
+		{"222 bytes", xxhSanityBuffer(222), 0, 0xb641ae8cb691c174},
+		{"222 bytes with seed", xxhSanityBuffer(222), prime32, 0x20cb8ab7ae10c14a},
+		{"a", []byte("a"), 0, 0xd24ec4f1a98c6e5b},
+		{"abc", []byte("abc"), 0, 0x44bc2cf5ad770999},
+		{"alphabet", []byte("abcdefghijklmnopqrstuvwxyz"), 0, 0xcfe1f278fa89835c},
+		{"fox", []byte("The quick brown fox jumps over the lazy dog"), 0, 0x0b242d361fda71bc},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			if got := XXH64(tt.data, tt.seed); got != tt.want {
+				t.Errorf("XXH64() = %#x, want %#x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnyEntryPoints(t *testing.T) {
+	// The entry points for any input hash the canonical encoding of the input with the byte-oriented entry points.
+	name := "encoded input"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		in, _ := convertToByteArray("abc")
+		if got, _ := DJB2("abc"); got != DJB2Bytes(in) {
+			t.Errorf("DJB2() = %v, want %v", got, DJB2Bytes(in))
+		}
+		if got, _ := XxHash("abc"); got != XXH64(in, 0) {
+			t.Errorf("XxHash() = %v, want %v", got, XXH64(in, 0))
+		}
+		if got, _ := Murmur3("abc", 7757); got != Murmur3_32(in, 7757) {
+			t.Errorf("Murmur3() = %v, want %v", got, Murmur3_32(in, 7757))
+		}
+		if _, err := XxHash(func() {}); !errors.Is(err, ErrUnsupportedType) {
+			t.Errorf("XxHash() error = %v, want %v", err, ErrUnsupportedType)
+		}
+	})
+}
+
+func TestMurmur3Fast(t *testing.T) {
+	type point struct {
+		X, Y int32
+	}
+	type padded struct {
+		A int8
+		B int64
+	}
+	type withPointer struct {
+		Name string
+		P    *point
+	}
+	type cyclic struct {
+		Name string
+		Self *cyclic
+	}
+	ref := withPointer{"ref", &point{1, 2}}
+	self := &cyclic{Name: "self"}
+	self.Self = self
+	type testCase struct {
+		name      string
+		a, b      func() (uint32, error)
+		wantEqual bool
+	}
+	tests := []testCase{
+		{
+			"equal ints",
+			func() (uint32, error) { return Murmur3Fast(123, 7757) },
+			func() (uint32, error) { return Murmur3Fast(123, 7757) },
+			true,
+		},
+		{
+			"different ints",
+			func() (uint32, error) { return Murmur3Fast(123, 7757) },
+			func() (uint32, error) { return Murmur3Fast(124, 7757) },
+			false,
+		},
+		{
+			"different seeds",
+			func() (uint32, error) { return Murmur3Fast(123, 7757) },
+			func() (uint32, error) { return Murmur3Fast(123, 7758) },
+			false,
+		},
+		{
+			"string and byte slice share their bytes",
+			func() (uint32, error) { return Murmur3Fast("hash me", 7757) },
+			func() (uint32, error) { return Murmur3Fast([]byte("hash me"), 7757) },
+			true,
+		},
+		{
+			"string is hashed without encoding",
+			func() (uint32, error) { return Murmur3Fast("abc", 0) },
+			func() (uint32, error) { return murmur3([]byte("abc"), 0), nil },
+			true,
+		},
+		{
+			"-0 and +0",
+			func() (uint32, error) { return Murmur3Fast(0.0, 7757) },
+			func() (uint32, error) { return Murmur3Fast(math.Copysign(0, -1), 7757) },
+			true,
+		},
+		{
+			"equal structs",
+			func() (uint32, error) { return Murmur3Fast(point{1, 2}, 7757) },
+			func() (uint32, error) { return Murmur3Fast(point{1, 2}, 7757) },
+			true,
+		},
+		{
+			"different structs",
+			func() (uint32, error) { return Murmur3Fast(point{1, 2}, 7757) },
+			func() (uint32, error) { return Murmur3Fast(point{2, 1}, 7757) },
+			false,
+		},
+		{
+			"struct with padding falls back to Murmur3",
+			func() (uint32, error) { return Murmur3Fast(padded{1, 2}, 7757) },
+			func() (uint32, error) { return Murmur3(padded{1, 2}, 7757) },
+			true,
+		},
+		{
+			"pointer is hashed by address after the pointee changed",
+			func() (uint32, error) { return Murmur3Fast(ref, 7757) },
+			func() (uint32, error) { ref.P.X = 3; return Murmur3Fast(ref, 7757) },
+			true,
+		},
+		{
+			"pointers to equal values",
+			func() (uint32, error) { return Murmur3Fast(withPointer{"a", &point{1, 2}}, 7757) },
+			func() (uint32, error) { return Murmur3Fast(withPointer{"a", &point{1, 2}}, 7757) },
+			false,
+		},
+		{
+			"key pointing to itself",
+			func() (uint32, error) { return Murmur3Fast(*self, 7757) },
+			func() (uint32, error) { return Murmur3Fast(cyclic{"self", self}, 7757) },
+			true,
+		},
+		{
+			"interface holding a pointer",
+			func() (uint32, error) { return Murmur3Fast[any](self, 7757) },
+			func() (uint32, error) { self.Name = "again"; return Murmur3Fast[any](self, 7757) },
+			true,
+		},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			a, errA := tt.a()
+			b, errB := tt.b()
+			if errA != nil || errB != nil {
+				t.Errorf("Murmur3Fast() threw error: %v, %v", errA, errB)
+			}
+			if (a == b) != tt.wantEqual {
+				t.Errorf("Murmur3Fast() a = %v, b = %v, wantEqual %v", a, b, tt.wantEqual)
+			}
+		})
+	}
+}
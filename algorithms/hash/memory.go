@@ -0,0 +1,116 @@
+package hash
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// memoryHashable caches per type whether its memory representation can be hashed directly.
+var memoryHashable sync.Map
+
+// memoryBytes returns the bytes of key which can be hashed without encoding it first and whether this was possible.
+//
+// Numbers are written into buf, so that an int has the same bytes on every platform.
+// Strings and byte slices are returned without copying them.
+// Pointers, channels, fixed-size structs and arrays are returned as their raw memory if they are made of
+// booleans, integers and pointers only and do not contain any padding.
+func memoryBytes[K any](key *K, buf *[8]byte) ([]byte, bool) {
+	switch k := any(*key).(type) {
+	case string:
+		return unsafe.Slice(unsafe.StringData(k), len(k)), true
+	case []byte:
+		return k, true
+	case bool:
+		if k {
+			buf[0] = 1
+		}
+		return buf[:1], true
+	case int:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+		return buf[:], true
+	case int8:
+		buf[0] = byte(k)
+		return buf[:1], true
+	case int16:
+		binary.LittleEndian.PutUint16(buf[:], uint16(k))
+		return buf[:2], true
+	case int32:
+		binary.LittleEndian.PutUint32(buf[:], uint32(k))
+		return buf[:4], true
+	case int64:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+		return buf[:], true
+	case uint:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+		return buf[:], true
+	case uint8:
+		buf[0] = k
+		return buf[:1], true
+	case uint16:
+		binary.LittleEndian.PutUint16(buf[:], k)
+		return buf[:2], true
+	case uint32:
+		binary.LittleEndian.PutUint32(buf[:], k)
+		return buf[:4], true
+	case uint64:
+		binary.LittleEndian.PutUint64(buf[:], k)
+		return buf[:], true
+	case uintptr:
+		binary.LittleEndian.PutUint64(buf[:], uint64(k))
+		return buf[:], true
+	case float32:
+		// -0 and +0 are equal, so they need to have the same bytes.
+		if k == 0 {
+			k = 0
+		}
+		binary.LittleEndian.PutUint32(buf[:], math.Float32bits(k))
+		return buf[:4], true
+	case float64:
+		if k == 0 {
+			k = 0
+		}
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(k))
+		return buf[:], true
+	}
+
+	t := reflect.TypeFor[K]()
+	if !isMemoryHashable(t) {
+		return nil, false
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(key)), t.Size()), true
+}
+
+// isMemoryHashable reports whether two equal values of type t always have identical memory.
+func isMemoryHashable(t reflect.Type) bool {
+	if ok, found := memoryHashable.Load(t); found {
+		return ok.(bool)
+	}
+
+	ok := false
+	switch t.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Pointer, reflect.UnsafePointer, reflect.Chan:
+		ok = true
+	case reflect.Array:
+		ok = isMemoryHashable(t.Elem())
+	case reflect.Struct:
+		// Padding bytes are not guaranteed to be zero, so structs with padding can not be hashed by their memory.
+		var size uintptr
+		ok = true
+		for i := 0; i < t.NumField() && ok; i++ {
+			f := t.Field(i)
+			size += f.Type.Size()
+			ok = f.Name != "_" && isMemoryHashable(f.Type)
+		}
+		ok = ok && size == t.Size()
+	}
+	// Floats (-0 == +0), strings, interfaces and everything else are not hashable by their memory.
+
+	memoryHashable.Store(t, ok)
+	return ok
+}
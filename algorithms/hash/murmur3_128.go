@@ -0,0 +1,111 @@
+package hash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+/*
+MurmurHash3 x64_128 by Austin Appleby works on blocks of 16 bytes with two 64-bit halves h1 and h2, which are mixed into each other.
+It is faster than x86_32 on 64-bit CPUs and its 128 bits are enough for very large tables and for Bloom filters:
+
+A Bloom filter needs k independent hashes per key. Instead of hashing the key k times with k seeds,
+double hashing (Kirsch and Mitzenmacher, "Less Hashing, Same Performance") derives all of them from the two halves
+of one digest as g_i = h1 + i * h2, without increasing the false positive rate asymptotically.
+*/
+
+const (
+	murmur128C1 uint64 = 0x87c37b91114253d5
+	murmur128C2 uint64 = 0x4cf5ad432745937f
+)
+
+// Murmur3_128 calculates the MurmurHash3 x64_128 of data with seed. Runtime O(n)
+//
+// The results match the reference implementation. h1 and h2 are the first and the second 8 bytes of the digest
+// as little-endian numbers.
+func Murmur3_128(data []byte, seed uint32) (h1, h2 uint64) {
+	h1, h2 = uint64(seed), uint64(seed)
+	length := uint64(len(data))
+
+	for ; len(data) >= 16; data = data[16:] {
+		k1 := binary.LittleEndian.Uint64(data[0:8])
+		k2 := binary.LittleEndian.Uint64(data[8:16])
+
+		h1 ^= murmur128MixK1(k1)
+		h1 = bits.RotateLeft64(h1, 27)
+		h1 += h2
+		h1 = h1*5 + 0x52dce729
+
+		h2 ^= murmur128MixK2(k2)
+		h2 = bits.RotateLeft64(h2, 31)
+		h2 += h1
+		h2 = h2*5 + 0x38495ab5
+	}
+
+	// The tail of 0-15 bytes is read like little-endian numbers, the bytes 8-15 into k2 and 0-7 into k1.
+	var k1, k2 uint64
+	for i := len(data) - 1; i >= 8; i-- {
+		k2 ^= uint64(data[i]) << ((i - 8) * 8)
+	}
+	if len(data) > 8 {
+		h2 ^= murmur128MixK2(k2)
+	}
+	for i := min(len(data), 8) - 1; i >= 0; i-- {
+		k1 ^= uint64(data[i]) << (i * 8)
+	}
+	if len(data) > 0 {
+		h1 ^= murmur128MixK1(k1)
+	}
+
+	h1 ^= length
+	h2 ^= length
+	h1 += h2
+	h2 += h1
+	h1 = fmix64(h1)
+	h2 = fmix64(h2)
+	h1 += h2
+	h2 += h1
+
+	return h1, h2
+}
+
+// Murmur3_64 calculates the first 64 bits (h1) of Murmur3_128. Runtime O(n)
+func Murmur3_64(data []byte, seed uint32) uint64 {
+	h1, _ := Murmur3_128(data, seed)
+	return h1
+}
+
+// DoubleHashes derives k hash values from the two halves h1 and h2 of one 128-bit digest, e.g. of Murmur3_128. Runtime O(k)
+//
+// The i-th value is h1 + i * h2. Map them to m buckets with value % m.
+func DoubleHashes(h1, h2 uint64, k int) []uint64 {
+	hashes := make([]uint64, k)
+	for i := range hashes {
+		hashes[i] = h1 + uint64(i)*h2
+	}
+	return hashes
+}
+
+// murmur128MixK1 mixes the first half k1 of a block before it is added to h1.
+func murmur128MixK1(k1 uint64) uint64 {
+	k1 *= murmur128C1
+	k1 = bits.RotateLeft64(k1, 31)
+	return k1 * murmur128C2
+}
+
+// murmur128MixK2 mixes the second half k2 of a block before it is added to h2.
+func murmur128MixK2(k2 uint64) uint64 {
+	k2 *= murmur128C2
+	k2 = bits.RotateLeft64(k2, 33)
+	return k2 * murmur128C1
+}
+
+// fmix64 lets every input bit affect every output bit.
+func fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+	return k
+}
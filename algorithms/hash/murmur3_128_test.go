@@ -0,0 +1,150 @@
+package hash
+
+import (
+	"dsa/util/sugar"
+	"fmt"
+	"testing"
+)
+
+func TestMurmur3_128(t *testing.T) {
+	// Results of the MurmurHash3_x64_128 reference implementation from SMHasher.
+	type testCase struct {
+		data   string
+		seed   uint32
+		h1, h2 uint64
+	}
+	tests := []testCase{
+		{"", 0x0, 0x0, 0x0},
+		{"", 0x9747b28c, 0x392b208a1daabbb3, 0x93b0608fe302957a},
+		{"a", 0x0, 0x85555565f6597889, 0xe6b53a48510e895a},
+		{"a", 0x9747b28c, 0x5ce8d8512db25a1d, 0x9e6dab0f9208f004},
+		{"abc", 0x0, 0xb4963f3f3fad7867, 0x3ba2744126ca2d52},
+		{"abc", 0x9747b28c, 0x3743630dbfc3cedc, 0xcde0a23420b504bf},
+		{"hello", 0x0, 0xcbd8a7b341bd9b02, 0x5b1e906a48ae1d19},
+		{"hello", 0x9747b28c, 0x8c23d6856f071a2e, 0x2a905546b3c1cb83},
+		{"message digest", 0x0, 0x875d2c2d76147dfc, 0xf622b02a12bc6f39},
+		{"message digest", 0x9747b28c, 0xd11844368435d192, 0x744f0ced3013765e},
+		{"abcdefghijklmnop", 0x0, 0xc4ca3ca3224cb723, 0x4333d695b331eb1a},
+		{"abcdefghijklmnop", 0x9747b28c, 0x924cd7e751ce99d9, 0x97396ce78ef04737},
+		{"abcdefghijklmnopq", 0x0, 0x7564747f88bda657, 0xecda499da1110de4},
+		{"abcdefghijklmnopq", 0x9747b28c, 0x42eb73cd91a97da6, 0x12001c04fbbee318},
+		{"The quick brown fox jumps over the lazy dog", 0x0, 0xe34bbc7bbc071b6c, 0x7a433ca9c49a9347},
+		{"The quick brown fox jumps over the lazy dog", 0x9747b28c, 0x738a7f3bd2633121, 0xf94573727ec016e5},
+	}
+	for _, tt := range tests {
+		name := fmt.Sprintf("%q seed %#x", tt.data, tt.seed)
+		println()
+		t.Run(name, func(t *testing.T) {
+			defer sugar.Lite(t, name)
+			if h1, h2 := Murmur3_128([]byte(tt.data), tt.seed); h1 != tt.h1 || h2 != tt.h2 {
+				t.Errorf("Murmur3_128() = %#x, %#x, want %#x, %#x", h1, h2, tt.h1, tt.h2)
+			}
+			if got := Murmur3_64([]byte(tt.data), tt.seed); got != tt.h1 {
+				t.Errorf("Murmur3_64() = %#x, want %#x", got, tt.h1)
+			}
+		})
+	}
+}
+
+func TestMurmur3_128_TailLengths(t *testing.T) {
+	// Every length of the tail of 0-15 bytes and blocks of 16 bytes of the input 0, 1, 2, ... with seed 0.
+	want := [][2]uint64{
+		{0x0, 0x0},                               // 0 bytes
+		{0x4610abe56eff5cb5, 0x51622daa78f83583}, // 1 bytes
+		{0x7cb3f5c58dab264c, 0xf03c01326ec2e044}, // 2 bytes
+		{0xb872a12fef53e6be, 0xfb6255c252b396b6}, // 3 bytes
+		{0xe1c594ae0ddfaf10, 0xd3d605bd13c2fde2}, // 4 bytes
+		{0x41ee8cd4a6f94036, 0xf8d0155e630c23f6}, // 5 bytes
+		{0x66983abba4f5043c, 0x57e0240b16512ca0}, // 6 bytes
+		{0xbd4c6987ca4b0d68, 0x613addd4bd25c787}, // 7 bytes
+		{0x47a7e1bdd68e2fc8, 0x60e6ee02ec31dcc7}, // 8 bytes
+		{0xfbb4cb0f6e812d32, 0x78de751d0200ffb9}, // 9 bytes
+		{0xcfca25e89e58e463, 0x254313c472ad2076}, // 10 bytes
+		{0xc57b4f47c7564f88, 0x6965ea8d20711bb0}, // 11 bytes
+		{0xb35da7e69212a5ca, 0x8075f146ecf75346}, // 12 bytes
+		{0x4b52d9f2c55f41c2, 0x84ff869eafa6d8fc}, // 13 bytes
+		{0x5fa933ee35906d64, 0xd782573380fc9df},  // 14 bytes
+		{0x47231598fd4925e9, 0xcd846dee88c67de9}, // 15 bytes
+		{0x444924b591903f30, 0xab906456762fe845}, // 16 bytes
+		{0x5c76f40f9fe7c20e, 0xc15f026b9edaa824}, // 17 bytes
+		{0x1648e4d1bae9c47b, 0xce2684180bb9eb5f}, // 18 bytes
+		{0xa665a45564a30163, 0x954f7960bfae1fdc}, // 19 bytes
+		{0xa3d253705736954b, 0x5a1f06256c7b9c7a}, // 20 bytes
+		{0xcdd13da028d41203, 0x6bc50efc2da2e7cb}, // 21 bytes
+		{0x45f8f3bf27c12a71, 0x1730a373601e5662}, // 22 bytes
+		{0xb9a1376f153581db, 0x26451c93b42a4941}, // 23 bytes
+		{0x734e846275b2dfde, 0x94707eabbbfb9e52}, // 24 bytes
+		{0x3bbe7cb52ee982cb, 0xa2d35433beef9ffc}, // 25 bytes
+		{0xa515dcfe02226f72, 0x2e0c68dcfcc47d78}, // 26 bytes
+		{0x111602884d592eed, 0x28741948dc1db519}, // 27 bytes
+		{0xdc14ff62ee05813c, 0x662050bb4567cb8b}, // 28 bytes
+		{0xaae494b11518cf1a, 0x649e12f78ccfe176}, // 29 bytes
+		{0xf069080d38a201e3, 0x22f3687fe3d26b19}, // 30 bytes
+		{0x53dd3e1a32cd094, 0x9ee59aefb4005490},  // 31 bytes
+		{0xc66d9022b62f500f, 0x1c050a6e34c31151}, // 32 bytes
+		{0x7d41281bfaba4612, 0x55ac8073a7d6a30b}, // 33 bytes
+	}
+	name := "tail lengths"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		data := make([]byte, len(want))
+		for i := range data {
+			data[i] = byte(i)
+		}
+		for n, w := range want {
+			if h1, h2 := Murmur3_128(data[:n], 0); h1 != w[0] || h2 != w[1] {
+				t.Errorf("Murmur3_128() of %v bytes = %#x, %#x, want %#x, %#x", n, h1, h2, w[0], w[1])
+			}
+		}
+	})
+}
+
+func TestDoubleHashes(t *testing.T) {
+	type testCase struct {
+		name   string
+		h1, h2 uint64
+		k      int
+		want   []uint64
+	}
+	tests := []testCase{
+		{"no hashes", 1, 2, 0, []uint64{}},
+		{"g_i = h1 + i * h2", 10, 3, 4, []uint64{10, 13, 16, 19}},
+		{"overflow wraps around", 1<<63 + 1, 1 << 63, 3, []uint64{1<<63 + 1, 1, 1<<63 + 1}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			got := DoubleHashes(tt.h1, tt.h2, tt.k)
+			if len(got) != len(tt.want) {
+				t.Fatalf("DoubleHashes() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("DoubleHashes() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+	println()
+	t.Run("bloom filter bits", func(t *testing.T) {
+		defer sugar.Lite(t, "bloom filter bits")
+		// The k bit positions of one key should rarely collide in a filter of m bits.
+		const k, m = 7, 1 << 16
+		collisions := 0
+		for key := range 1000 {
+			h1, h2 := Murmur3_128([]byte{byte(key), byte(key >> 8)}, 0)
+			seen := make(map[uint64]bool)
+			for _, h := range DoubleHashes(h1, h2, k) {
+				if seen[h%m] {
+					collisions++
+				}
+				seen[h%m] = true
+			}
+		}
+		if collisions > 5 {
+			t.Errorf("DoubleHashes() had %v collisions within the bits of a key, want at most 5", collisions)
+		}
+	})
+}
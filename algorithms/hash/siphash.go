@@ -0,0 +1,99 @@
+package hash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+/*
+SipHash by Jean-Philippe Aumasson and Daniel J. Bernstein (https://www.aumasson.jp/siphash/siphash.pdf).
+
+Murmur3, xxHash and DJB2 are fast, but not designed against attackers: with a known (or guessed) seed, anyone can compute
+many keys which collide into the same bucket and turn every HashMap operation into O(n) (hash-flooding DoS attack).
+SipHash is a pseudorandom function keyed with a secret 128-bit key. Without the key, its output can not be predicted,
+so colliding keys can not be precomputed. This is why Python, Rust and many other languages use it for their hash tables.
+
+SipHash-c-d works on blocks of 8 bytes with a state of 4 words v0-v3 initialized from the key.
+Every block is mixed in with c SipRounds and the final state with d SipRounds.
+SipHash-2-4 is the conservative original, SipHash-1-3 is faster and still considered secure enough for hash tables.
+*/
+
+// SipHash24 calculates the SipHash-2-4 of data with the 128-bit key. Runtime O(n)
+//
+// The results match the reference implementation. The key is read as two little-endian words like in the reference.
+func SipHash24(data []byte, key [16]byte) uint64 {
+	return sipHash(data, key, 2, 4)
+}
+
+// SipHash13 calculates the SipHash-1-3 of data with the 128-bit key. Runtime O(n)
+//
+// It is faster than SipHash24 with fewer rounds and the results match the reference implementation.
+func SipHash13(data []byte, key [16]byte) uint64 {
+	return sipHash(data, key, 1, 3)
+}
+
+// SipHash calculates SipHash24 of the canonically encoded input with the 128-bit key. Non-nil funcs are not supported.
+func SipHash(input any, key [16]byte) (uint64, error) {
+	in, err := convertToByteArray(input)
+	if err != nil {
+		return 0, err
+	}
+
+	return SipHash24(in, key), nil
+}
+
+// sipHash is the SipHash-c-d core with c compression rounds per block and d finalization rounds.
+func sipHash(data []byte, key [16]byte, c, d int) uint64 {
+	k0 := binary.LittleEndian.Uint64(key[0:8])
+	k1 := binary.LittleEndian.Uint64(key[8:16])
+	// The constants are "somepseudorandomlygeneratedbytes" in ASCII.
+	v := sipState{k0 ^ 0x736f6d6570736575, k1 ^ 0x646f72616e646f6d, k0 ^ 0x6c7967656e657261, k1 ^ 0x7465646279746573}
+	length := len(data)
+
+	for ; len(data) >= 8; data = data[8:] {
+		v.compress(binary.LittleEndian.Uint64(data), c)
+	}
+
+	// The last block holds the remaining 0-7 bytes and the lowest byte of the length in its highest byte.
+	b := uint64(length) << 56
+	for i := len(data) - 1; i >= 0; i-- {
+		b |= uint64(data[i]) << (i * 8)
+	}
+	v.compress(b, c)
+
+	v[2] ^= 0xff
+	for i := 0; i < d; i++ {
+		v.round()
+	}
+	return v[0] ^ v[1] ^ v[2] ^ v[3]
+}
+
+// sipState is the internal state v0-v3 of SipHash.
+type sipState [4]uint64
+
+// compress mixes the block m into the state with c SipRounds.
+func (v *sipState) compress(m uint64, c int) {
+	v[3] ^= m
+	for i := 0; i < c; i++ {
+		v.round()
+	}
+	v[0] ^= m
+}
+
+// round is one SipRound of additions, rotations and XORs (ARX).
+func (v *sipState) round() {
+	v[0] += v[1]
+	v[1] = bits.RotateLeft64(v[1], 13)
+	v[1] ^= v[0]
+	v[0] = bits.RotateLeft64(v[0], 32)
+	v[2] += v[3]
+	v[3] = bits.RotateLeft64(v[3], 16)
+	v[3] ^= v[2]
+	v[0] += v[3]
+	v[3] = bits.RotateLeft64(v[3], 21)
+	v[3] ^= v[0]
+	v[2] += v[1]
+	v[1] = bits.RotateLeft64(v[1], 17)
+	v[1] ^= v[2]
+	v[2] = bits.RotateLeft64(v[2], 32)
+}
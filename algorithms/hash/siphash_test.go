@@ -0,0 +1,113 @@
+package hash
+
+import (
+	"dsa/util/sugar"
+	"fmt"
+	"testing"
+)
+
+// sipVectors24 are the SipHash-2-4 test vectors of the reference implementation (vectors.h) for the key 00 01 .. 0f
+// and the messages 00 01 .. (n-1) of length n = 0..63.
+var sipVectors24 = [64]uint64{
+	0x726fdb47dd0e0e31, 0x74f839c593dc67fd, 0x0d6c8009d9a94f5a, 0x85676696d7fb7e2d,
+	0xcf2794e0277187b7, 0x18765564cd99a68d, 0xcbc9466e58fee3ce, 0xab0200f58b01d137,
+	0x93f5f5799a932462, 0x9e0082df0ba9e4b0, 0x7a5dbbc594ddb9f3, 0xf4b32f46226bada7,
+	0x751e8fbc860ee5fb, 0x14ea5627c0843d90, 0xf723ca908e7af2ee, 0xa129ca6149be45e5,
+	0x3f2acc7f57c29bdb, 0x699ae9f52cbe4794, 0x4bc1b3f0968dd39c, 0xbb6dc91da77961bd,
+	0xbed65cf21aa2ee98, 0xd0f2cbb02e3b67c7, 0x93536795e3a33e88, 0xa80c038ccd5ccec8,
+	0xb8ad50c6f649af94, 0xbce192de8a85b8ea, 0x17d835b85bbb15f3, 0x2f2e6163076bcfad,
+	0xde4daaaca71dc9a5, 0xa6a2506687956571, 0xad87a3535c49ef28, 0x32d892fad841c342,
+	0x7127512f72f27cce, 0xa7f32346f95978e3, 0x12e0b01abb051238, 0x15e034d40fa197ae,
+	0x314dffbe0815a3b4, 0x027990f029623981, 0xcadcd4e59ef40c4d, 0x9abfd8766a33735c,
+	0x0e3ea96b5304a7d0, 0xad0c42d6fc585992, 0x187306c89bc215a9, 0xd4a60abcf3792b95,
+	0xf935451de4f21df2, 0xa9538f0419755787, 0xdb9acddff56ca510, 0xd06c98cd5c0975eb,
+	0xe612a3cb9ecba951, 0xc766e62cfcadaf96, 0xee64435a9752fe72, 0xa192d576b245165a,
+	0x0a8787bf8ecb74b2, 0x81b3e73d20b49b6f, 0x7fa8220ba3b2ecea, 0x245731c13ca42499,
+	0xb78dbfaf3a8d83bd, 0xea1ad565322a1a0b, 0x60e61c23a3795013, 0x6606d7e446282b93,
+	0x6ca4ecb15c5f91e1, 0x9f626da15c9625f3, 0xe51b38608ef25f57, 0x958a324ceb064572,
+}
+
+// sequence returns the bytes 00 01 .. (n-1).
+func sequence(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+func TestSipHash24(t *testing.T) {
+	var key [16]byte
+	copy(key[:], sequence(16))
+	for n, want := range sipVectors24 {
+		name := fmt.Sprintf("%v bytes", n)
+		println()
+		t.Run(name, func(t *testing.T) {
+			defer sugar.Lite(t, name)
+			if got := SipHash24(sequence(n), key); got != want {
+				t.Errorf("SipHash24() = %#x, want %#x", got, want)
+			}
+		})
+	}
+}
+
+func TestSipHash13(t *testing.T) {
+	// The hashes of bytes objects in CPython 3.11+ (PYTHONHASHSEED=0 uses the zero key) for the messages 00 01 .. (n-1).
+	type testCase struct {
+		n    int
+		want uint64
+	}
+	tests := []testCase{
+		{1, 0x68a914128e01e473},
+		{2, 0x010bac45c41e3669},
+		{3, 0x4d4c9a4a8ef6e0ad},
+		{4, 0x7cc43f98813e4dbd},
+		{5, 0x5abe2169dff36275},
+		{6, 0xe3c25f87624f1cdb},
+		{7, 0x2f098ab0c751325a},
+		{8, 0xead411e67ebe2eea},
+		{9, 0x75927f9d95124362},
+		{12, 0xa6baf4fb0f9fe1c2},
+		{15, 0xf30eb725bb91c9ea},
+		{16, 0x8972188433a5c5b7},
+	}
+	for _, tt := range tests {
+		name := fmt.Sprintf("%v bytes", tt.n)
+		println()
+		t.Run(name, func(t *testing.T) {
+			defer sugar.Lite(t, name)
+			if got := SipHash13(sequence(tt.n), [16]byte{}); got != tt.want {
+				t.Errorf("SipHash13() = %#x, want %#x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSipHash_Key(t *testing.T) {
+	name := "different keys"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		var k1, k2 [16]byte
+		k2[15] = 1
+		data := []byte("The quick brown fox jumps over the lazy dog")
+		if SipHash24(data, k1) == SipHash24(data, k2) {
+			t.Errorf("SipHash24() with different keys gave the same hash")
+		}
+		if SipHash13(data, k1) == SipHash24(data, k1) {
+			t.Errorf("SipHash13() and SipHash24() gave the same hash")
+		}
+
+		got, err := SipHash("fox", k2)
+		if err != nil {
+			t.Fatalf("SipHash() threw error: %v", err)
+		}
+		in, _ := convertToByteArray("fox")
+		if want := SipHash24(in, k2); got != want {
+			t.Errorf("SipHash() = %#x, want %#x", got, want)
+		}
+		if _, err := SipHash(func() {}, k2); err == nil {
+			t.Errorf("SipHash() of a func did not throw an error")
+		}
+	})
+}
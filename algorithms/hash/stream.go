@@ -0,0 +1,148 @@
+package hash
+
+import (
+	"encoding/binary"
+	stdhash "hash"
+)
+
+/*
+Streaming versions of Murmur3_32 and XXH64 for input which does not fit into memory at once, e.g. large files or an io.Reader.
+
+Both algorithms work on fixed-size blocks (4 bytes for Murmur3, stripes of 32 bytes for xxHash64). A digest keeps the state of
+the blocks hashed so far and buffers the bytes of an incomplete block until the next Write completes it.
+Only the final Sum mixes in the buffered bytes and the total length, so it does not change the state and more bytes can be written afterward.
+
+Writing the same bytes in any chunks gives the same hash as the one-shot function.
+*/
+
+var (
+	_ stdhash.Hash32 = (*Murmur3Digest)(nil)
+	_ stdhash.Hash64 = (*XXH64Digest)(nil)
+)
+
+// Murmur3Digest calculates Murmur3_32 incrementally. It implements hash.Hash32 of the standard library.
+type Murmur3Digest struct {
+	seed uint32
+	hash uint32
+	// tail buffers the bytes of an incomplete block.
+	tail    [4]byte
+	tailLen int
+	length  uint32
+}
+
+// NewMurmur3Digest creates a new Murmur3Digest with seed. Runtime O(1)
+func NewMurmur3Digest(seed uint32) *Murmur3Digest {
+	return &Murmur3Digest{seed: seed, hash: seed}
+}
+
+// Write adds p to the hashed bytes. It never returns an error. Runtime O(len(p))
+func (d *Murmur3Digest) Write(p []byte) (int, error) {
+	n := len(p)
+	d.length += uint32(n)
+
+	if d.tailLen > 0 {
+		c := copy(d.tail[d.tailLen:], p)
+		d.tailLen += c
+		p = p[c:]
+		if d.tailLen < len(d.tail) {
+			return n, nil
+		}
+		d.hash, _ = murmur3Blocks(d.hash, d.tail[:])
+		d.tailLen = 0
+	}
+
+	var rest []byte
+	d.hash, rest = murmur3Blocks(d.hash, p)
+	d.tailLen = copy(d.tail[:], rest)
+	return n, nil
+}
+
+// Sum32 returns the hash of all bytes written so far. Runtime O(1)
+func (d *Murmur3Digest) Sum32() uint32 {
+	return murmur3Finalize(d.hash, d.tail[:d.tailLen], d.length)
+}
+
+// Sum appends the big-endian bytes of Sum32 to b. Runtime O(1)
+func (d *Murmur3Digest) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint32(b, d.Sum32())
+}
+
+// Reset resets the Murmur3Digest to its initial state with its seed. Runtime O(1)
+func (d *Murmur3Digest) Reset() {
+	*d = Murmur3Digest{seed: d.seed, hash: d.seed}
+}
+
+// Size returns the number of bytes Sum appends.
+func (d *Murmur3Digest) Size() int {
+	return 4
+}
+
+// BlockSize returns the size of the blocks Murmur3 works on.
+func (d *Murmur3Digest) BlockSize() int {
+	return 4
+}
+
+// XXH64Digest calculates XXH64 incrementally. It implements hash.Hash64 of the standard library.
+type XXH64Digest struct {
+	seed uint64
+	v    xxAccumulators
+	// mem buffers the bytes of an incomplete stripe.
+	mem    [32]byte
+	memLen int
+	length uint64
+}
+
+// NewXXH64Digest creates a new XXH64Digest with seed. Runtime O(1)
+func NewXXH64Digest(seed uint64) *XXH64Digest {
+	return &XXH64Digest{seed: seed, v: xxLanes(seed)}
+}
+
+// Write adds p to the hashed bytes. It never returns an error. Runtime O(len(p))
+func (d *XXH64Digest) Write(p []byte) (int, error) {
+	n := len(p)
+	d.length += uint64(n)
+
+	if d.memLen > 0 {
+		c := copy(d.mem[d.memLen:], p)
+		d.memLen += c
+		p = p[c:]
+		if d.memLen < len(d.mem) {
+			return n, nil
+		}
+		d.v.stripes(d.mem[:])
+		d.memLen = 0
+	}
+
+	rest := d.v.stripes(p)
+	d.memLen = copy(d.mem[:], rest)
+	return n, nil
+}
+
+// Sum64 returns the hash of all bytes written so far. Runtime O(1)
+func (d *XXH64Digest) Sum64() uint64 {
+	h := d.seed + xxPrime5
+	if d.length >= 32 {
+		h = d.v.merge()
+	}
+	return xxFinalize(h, d.mem[:d.memLen], d.length)
+}
+
+// Sum appends the big-endian bytes of Sum64 to b. Runtime O(1)
+func (d *XXH64Digest) Sum(b []byte) []byte {
+	return binary.BigEndian.AppendUint64(b, d.Sum64())
+}
+
+// Reset resets the XXH64Digest to its initial state with its seed. Runtime O(1)
+func (d *XXH64Digest) Reset() {
+	*d = XXH64Digest{seed: d.seed, v: xxLanes(d.seed)}
+}
+
+// Size returns the number of bytes Sum appends.
+func (d *XXH64Digest) Size() int {
+	return 8
+}
+
+// BlockSize returns the size of the stripes xxHash64 works on.
+func (d *XXH64Digest) BlockSize() int {
+	return 32
+}
@@ -0,0 +1,144 @@
+package hash
+
+import (
+	"bytes"
+	"dsa/util/sugar"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+)
+
+// randomBytes returns n random bytes which are the same for every run.
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	rand.New(rand.NewSource(int64(n))).Read(b)
+	return b
+}
+
+// writeChunks writes data in chunks of size into w. The last chunk may be smaller.
+func writeChunks(w io.Writer, data []byte, size int) {
+	for len(data) > 0 {
+		n := min(size, len(data))
+		_, _ = w.Write(data[:n])
+		data = data[n:]
+	}
+}
+
+func TestDigests_Chunks(t *testing.T) {
+	type testCase struct {
+		name  string
+		sizes []int
+	}
+	tests := []testCase{
+		{"whole input", []int{1 << 20}},
+		{"single bytes", []int{1}},
+		{"smaller than a block", []int{3}},
+		{"odd chunks", []int{5, 7, 31, 33}},
+		{"block sized chunks", []int{4, 32}},
+		{"large chunks", []int{100, 1000}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			for _, n := range []int{0, 1, 3, 4, 5, 14, 31, 32, 33, 63, 64, 65, 100, 222, 4099} {
+				data := randomBytes(n)
+				for _, size := range tt.sizes {
+					m := NewMurmur3Digest(7757)
+					writeChunks(m, data, size)
+					if got, want := m.Sum32(), Murmur3_32(data, 7757); got != want {
+						t.Errorf("Murmur3Digest.Sum32() of %v bytes in chunks of %v = %#x, want %#x", n, size, got, want)
+					}
+
+					x := NewXXH64Digest(2654435761)
+					writeChunks(x, data, size)
+					if got, want := x.Sum64(), XXH64(data, 2654435761); got != want {
+						t.Errorf("XXH64Digest.Sum64() of %v bytes in chunks of %v = %#x, want %#x", n, size, got, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDigests_Reader(t *testing.T) {
+	type testCase struct {
+		name   string
+		reader func(r io.Reader) io.Reader
+	}
+	tests := []testCase{
+		{"whole reader", func(r io.Reader) io.Reader { return r }},
+		{"one byte reader", iotest.OneByteReader},
+		{"half reader", iotest.HalfReader},
+	}
+	data := randomBytes(1 << 16)
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			m := NewMurmur3Digest(0)
+			if _, err := io.Copy(m, tt.reader(bytes.NewReader(data))); err != nil {
+				t.Fatalf("io.Copy() threw error: %v", err)
+			}
+			if got, want := m.Sum32(), Murmur3_32(data, 0); got != want {
+				t.Errorf("Murmur3Digest.Sum32() = %#x, want %#x", got, want)
+			}
+
+			x := NewXXH64Digest(0)
+			if _, err := io.Copy(x, tt.reader(bytes.NewReader(data))); err != nil {
+				t.Fatalf("io.Copy() threw error: %v", err)
+			}
+			if got, want := x.Sum64(), XXH64(data, 0); got != want {
+				t.Errorf("XXH64Digest.Sum64() = %#x, want %#x", got, want)
+			}
+		})
+	}
+}
+
+func TestDigests_SumReset(t *testing.T) {
+	name := "sum, write more and reset"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		data := []byte("The quick brown fox jumps over the lazy dog")
+
+		m := NewMurmur3Digest(0x9747b28c)
+		_, _ = m.Write(data[:10])
+		prefix := m.Sum([]byte("prefix"))
+		if want := binary.BigEndian.AppendUint32([]byte("prefix"), Murmur3_32(data[:10], 0x9747b28c)); !bytes.Equal(prefix, want) {
+			t.Errorf("Murmur3Digest.Sum() = %x, want %x", prefix, want)
+		}
+		// Sum must not change the state.
+		_, _ = m.Write(data[10:])
+		if got := m.Sum32(); got != 0x2fa826cd {
+			t.Errorf("Murmur3Digest.Sum32() after Sum() = %#x, want %#x", got, 0x2fa826cd)
+		}
+		m.Reset()
+		if got := m.Sum32(); got != Murmur3_32(nil, 0x9747b28c) {
+			t.Errorf("Murmur3Digest.Sum32() after Reset() = %#x, want %#x", got, Murmur3_32(nil, 0x9747b28c))
+		}
+		if m.Size() != 4 || m.BlockSize() != 4 || len(m.Sum(nil)) != m.Size() {
+			t.Errorf("Murmur3Digest Size(), BlockSize() = %v, %v, want 4, 4", m.Size(), m.BlockSize())
+		}
+
+		x := NewXXH64Digest(0)
+		_, _ = x.Write(data[:10])
+		prefix = x.Sum([]byte("prefix"))
+		if want := binary.BigEndian.AppendUint64([]byte("prefix"), XXH64(data[:10], 0)); !bytes.Equal(prefix, want) {
+			t.Errorf("XXH64Digest.Sum() = %x, want %x", prefix, want)
+		}
+		_, _ = x.Write(data[10:])
+		if got := x.Sum64(); got != 0x0b242d361fda71bc {
+			t.Errorf("XXH64Digest.Sum64() after Sum() = %#x, want %#x", got, 0x0b242d361fda71bc)
+		}
+		x.Reset()
+		if got := x.Sum64(); got != 0xef46db3751d8e999 {
+			t.Errorf("XXH64Digest.Sum64() after Reset() = %#x, want %#x", got, uint64(0xef46db3751d8e999))
+		}
+		if x.Size() != 8 || x.BlockSize() != 32 || len(x.Sum(nil)) != x.Size() {
+			t.Errorf("XXH64Digest Size(), BlockSize() = %v, %v, want 8, 32", x.Size(), x.BlockSize())
+		}
+	})
+}
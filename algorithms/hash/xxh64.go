@@ -0,0 +1,107 @@
+package hash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+/*
+xxHash64 by Yann Collet (https://github.com/Cyan4973/xxHash/blob/dev/doc/xxhash_spec.md).
+
+Inputs of 32 bytes or more are split into stripes of 32 bytes. Every stripe is split into 4 lanes of 8 bytes, and every
+lane has its own accumulator. The lanes do not depend on each other, so a CPU can process all 4 at the same time.
+In the end, the accumulators are merged, the remaining bytes are mixed in 8, 4 and 1 at a time and the result is avalanched.
+*/
+
+const (
+	xxPrime1 uint64 = 11400714785074694791
+	xxPrime2 uint64 = 14029467366897019727
+	xxPrime3 uint64 = 1609587929392839161
+	xxPrime4 uint64 = 9650029242287828579
+	xxPrime5 uint64 = 2870177450012600261
+)
+
+// XXH64 calculates the xxHash64 of data with seed. The results match the reference implementation. Runtime O(n)
+func XXH64(data []byte, seed uint64) uint64 {
+	if len(data) < 32 {
+		return xxFinalize(seed+xxPrime5, data, uint64(len(data)))
+	}
+
+	v := xxLanes(seed)
+	tail := v.stripes(data)
+	return xxFinalize(v.merge(), tail, uint64(len(data)))
+}
+
+// xxAccumulators are the accumulators of the 4 lanes.
+type xxAccumulators [4]uint64
+
+// xxLanes returns the initial accumulators for seed.
+func xxLanes(seed uint64) xxAccumulators {
+	return xxAccumulators{seed + xxPrime1 + xxPrime2, seed + xxPrime2, seed, seed - xxPrime1}
+}
+
+// stripes mixes every full stripe of 32 bytes of data into the accumulators.
+//
+// Returns the remaining 0-31 bytes.
+func (v *xxAccumulators) stripes(data []byte) []byte {
+	for ; len(data) >= 32; data = data[32:] {
+		v[0] = xxRound(v[0], binary.LittleEndian.Uint64(data[0:8]))
+		v[1] = xxRound(v[1], binary.LittleEndian.Uint64(data[8:16]))
+		v[2] = xxRound(v[2], binary.LittleEndian.Uint64(data[16:24]))
+		v[3] = xxRound(v[3], binary.LittleEndian.Uint64(data[24:32]))
+	}
+	return data
+}
+
+// merge merges the accumulators into the hash.
+func (v *xxAccumulators) merge() uint64 {
+	h := bits.RotateLeft64(v[0], 1) + bits.RotateLeft64(v[1], 7) + bits.RotateLeft64(v[2], 12) + bits.RotateLeft64(v[3], 18)
+	for _, acc := range v {
+		h = xxMergeRound(h, acc)
+	}
+	return h
+}
+
+// xxFinalize adds the length of all input to h, mixes in the remaining 0-31 bytes of tail and avalanches it.
+func xxFinalize(h uint64, tail []byte, length uint64) uint64 {
+	h += length
+
+	for ; len(tail) >= 8; tail = tail[8:] {
+		h ^= xxRound(0, binary.LittleEndian.Uint64(tail))
+		h = bits.RotateLeft64(h, 27)*xxPrime1 + xxPrime4
+	}
+	if len(tail) >= 4 {
+		h ^= uint64(binary.LittleEndian.Uint32(tail)) * xxPrime1
+		h = bits.RotateLeft64(h, 23)*xxPrime2 + xxPrime3
+		tail = tail[4:]
+	}
+	for _, b := range tail {
+		h ^= uint64(b) * xxPrime5
+		h = bits.RotateLeft64(h, 11) * xxPrime1
+	}
+
+	return xxAvalanche(h)
+}
+
+// xxRound mixes one lane of 8 bytes into the accumulator acc.
+func xxRound(acc, lane uint64) uint64 {
+	acc += lane * xxPrime2
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * xxPrime1
+}
+
+// xxMergeRound mixes the accumulator of a lane into the hash h.
+func xxMergeRound(h, acc uint64) uint64 {
+	h ^= xxRound(0, acc)
+	return h*xxPrime1 + xxPrime4
+}
+
+// xxAvalanche lets every input bit affect every output bit.
+func xxAvalanche(h uint64) uint64 {
+	h ^= h >> 33
+	h *= xxPrime2
+	h ^= h >> 29
+	h *= xxPrime3
+	h ^= h >> 32
+	return h
+}
@@ -0,0 +1,203 @@
+package biMap
+
+import (
+	"dsa/datastructures/hashMap"
+	"errors"
+	"iter"
+)
+
+/*
+Bidirectional map: Every key maps to exactly one value and every value maps back to exactly one key.
+
+HashMap.GetKey and HashMap.ContainsVal have to scan every bucket, which is O(n). The BiMap keeps a second, reverse
+HashMap from value to key in sync with the forward HashMap, so that lookups by value are O(1) as well.
+This doubles the memory, and values have to be hashable like keys.
+
+Because every value may only belong to one key, putting a value which another key already has is a conflict.
+The DuplicatePolicy decides whether Put rejects it or moves the value to the new key.
+*/
+
+var ErrDuplicateValue = errors.New("biMap: value already belongs to another key")
+
+// DuplicatePolicy decides what Put does with a value which already belongs to another key.
+type DuplicatePolicy int
+
+const (
+	// RejectDuplicates lets Put return ErrDuplicateValue and leaves the BiMap unchanged.
+	RejectDuplicates DuplicatePolicy = iota
+	// ReplaceDuplicates removes the other key, so that the value belongs to the new key.
+	ReplaceDuplicates
+)
+
+type BiMap[K, V any] struct {
+	forward *hashMap.HashMap[K, V]
+	reverse *hashMap.HashMap[V, K]
+	policy  DuplicatePolicy
+}
+
+// NewBiMap creates a new BiMap with random seeds and the default Murmur3Hasher for keys and values. Runtime O(n)
+//
+// initialCapacity - The initial capacity of the BiMap on its creation.
+//
+// policy - What Put does with a value which already belongs to another key.
+func NewBiMap[K, V any](initialCapacity uint, policy DuplicatePolicy) *BiMap[K, V] {
+	return NewBiMapWithHashers[K, V](initialCapacity, policy, nil, nil)
+}
+
+// NewBiMapWithHashers creates a new BiMap with random seeds and the given Hashers for keys and values. Runtime O(n)
+//
+// A nil Hasher falls back to the default Murmur3Hasher.
+func NewBiMapWithHashers[K, V any](initialCapacity uint, policy DuplicatePolicy, keyHasher hashMap.Hasher[K], valHasher hashMap.Hasher[V]) *BiMap[K, V] {
+	var keyOpts []hashMap.Option[K]
+	if keyHasher != nil {
+		keyOpts = append(keyOpts, hashMap.WithHasher(keyHasher))
+	}
+	var valOpts []hashMap.Option[V]
+	if valHasher != nil {
+		valOpts = append(valOpts, hashMap.WithHasher(valHasher))
+	}
+
+	return &BiMap[K, V]{
+		forward: hashMap.NewHashMap[K, V](initialCapacity, keyOpts...),
+		reverse: hashMap.NewHashMap[V, K](initialCapacity, valOpts...),
+		policy:  policy,
+	}
+}
+
+// Put maps key to val and val back to key. Runtime average case O(1), worst case O(n) when resizing.
+//
+// If key already has a value, the old value is removed. If val already belongs to another key, the policy decides:
+// RejectDuplicates returns ErrDuplicateValue, ReplaceDuplicates removes the other key.
+func (bm *BiMap[K, V]) Put(key K, val V) error {
+	oldVal, err := bm.forward.Get(key)
+	if err != nil {
+		return err
+	}
+	otherKey, err := bm.reverse.Get(val)
+	if err != nil {
+		return err
+	}
+
+	if otherKey != nil {
+		if bm.forward.Hasher().Equal(*otherKey, key) {
+			// The pair exists already.
+			return nil
+		}
+		if bm.policy == RejectDuplicates {
+			return ErrDuplicateValue
+		}
+		if _, err := bm.forward.Remove(*otherKey); err != nil {
+			return err
+		}
+	}
+
+	if oldVal != nil {
+		if _, err := bm.reverse.Remove(*oldVal); err != nil {
+			return err
+		}
+	}
+
+	if err := bm.reverse.Insert(val, key); err != nil {
+		return err
+	}
+	if err := bm.forward.Insert(key, val); err != nil {
+		// Get does not hash while a HashMap is empty, so an unhashable key is only noticed here.
+		// Then the BiMap was empty and val is the only value, so removing it leaves the BiMap unchanged.
+		_, _ = bm.reverse.Remove(val)
+		return err
+	}
+	return nil
+}
+
+// GetByKey returns the value of key. Runtime O(1)
+//
+// Returns the value and whether it was found.
+func (bm *BiMap[K, V]) GetByKey(key K) (val V, found bool, err error) {
+	v, err := bm.forward.Get(key)
+	if err != nil || v == nil {
+		return val, false, err
+	}
+	return *v, true, nil
+}
+
+// GetByValue returns the key of val. Runtime O(1)
+//
+// Returns the key and whether it was found.
+func (bm *BiMap[K, V]) GetByValue(val V) (key K, found bool, err error) {
+	k, err := bm.reverse.Get(val)
+	if err != nil || k == nil {
+		return key, false, err
+	}
+	return *k, true, nil
+}
+
+// RemoveByKey removes key and its value. Runtime average case: O(1), worst case O(n) when downsizing.
+//
+// Returns the value and whether it was found.
+func (bm *BiMap[K, V]) RemoveByKey(key K) (val V, found bool, err error) {
+	v, err := bm.forward.Remove(key)
+	if err != nil || v == nil {
+		return val, false, err
+	}
+	if _, err := bm.reverse.Remove(*v); err != nil {
+		return val, false, err
+	}
+	return *v, true, nil
+}
+
+// RemoveByValue removes val and its key. Runtime average case: O(1), worst case O(n) when downsizing.
+//
+// Returns the key and whether it was found.
+func (bm *BiMap[K, V]) RemoveByValue(val V) (key K, found bool, err error) {
+	return bm.Inverse().RemoveByKey(val)
+}
+
+// ContainsKey - Check if key exists. Runtime O(1)
+func (bm *BiMap[K, V]) ContainsKey(key K) (bool, error) {
+	return bm.forward.ContainsKey(key)
+}
+
+// ContainsValue - Check if val exists. Runtime O(1)
+func (bm *BiMap[K, V]) ContainsValue(val V) (bool, error) {
+	return bm.reverse.ContainsKey(val)
+}
+
+// Len returns the number of key value pairs. Runtime O(1)
+func (bm *BiMap[K, V]) Len() uint {
+	return bm.forward.Size
+}
+
+// IsEmpty - Check if BiMap is emtpy. Runtime O(1)
+func (bm *BiMap[K, V]) IsEmpty() bool {
+	return bm.forward.IsEmpty()
+}
+
+// Keys returns an array of all keys. Runtime O(n)
+func (bm *BiMap[K, V]) Keys() []K {
+	return bm.forward.Keys()
+}
+
+// Values returns an array of all values. Runtime O(n)
+func (bm *BiMap[K, V]) Values() []V {
+	return bm.reverse.Keys()
+}
+
+// All returns an iterator over all key value pairs. Runtime O(n)
+//
+// The BiMap must not be modified while iterating.
+func (bm *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return bm.forward.All()
+}
+
+// Inverse returns a view of the BiMap with keys and values swapped. Runtime O(1)
+//
+// The view shares the HashMaps with the BiMap, so changes to either of them are visible in both.
+func (bm *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: bm.reverse, reverse: bm.forward, policy: bm.policy}
+}
+
+// Clear BiMap, resetting it to a newly initialized state. Runtime O(1)
+func (bm *BiMap[K, V]) Clear() {
+	bm.forward.Clear()
+	bm.reverse.Clear()
+}
@@ -0,0 +1,193 @@
+package biMap
+
+import (
+	"dsa/datastructures/hashMap"
+	"dsa/util/sugar"
+	"errors"
+	"maps"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// codes returns a BiMap of country names to country codes.
+func codes(policy DuplicatePolicy) *BiMap[string, string] {
+	bm := NewBiMap[string, string](0, policy)
+	_ = bm.Put("Germany", "DE")
+	_ = bm.Put("France", "FR")
+	_ = bm.Put("Italy", "IT")
+	return bm
+}
+
+func TestBiMap_Put(t *testing.T) {
+	type testCase struct {
+		name    string
+		policy  DuplicatePolicy
+		key     string
+		val     string
+		wantErr error
+		want    map[string]string
+	}
+	tests := []testCase{
+		{"new pair", RejectDuplicates, "Spain", "ES", nil,
+			map[string]string{"Germany": "DE", "France": "FR", "Italy": "IT", "Spain": "ES"}},
+		{"existing pair", RejectDuplicates, "Italy", "IT", nil,
+			map[string]string{"Germany": "DE", "France": "FR", "Italy": "IT"}},
+		{"new value for key", RejectDuplicates, "Germany", "DEU", nil,
+			map[string]string{"Germany": "DEU", "France": "FR", "Italy": "IT"}},
+		{"reject duplicate value", RejectDuplicates, "Deutschland", "DE", ErrDuplicateValue,
+			map[string]string{"Germany": "DE", "France": "FR", "Italy": "IT"}},
+		{"replace duplicate value", ReplaceDuplicates, "Deutschland", "DE", nil,
+			map[string]string{"Deutschland": "DE", "France": "FR", "Italy": "IT"}},
+		{"replace duplicate value of existing key", ReplaceDuplicates, "France", "DE", nil,
+			map[string]string{"France": "DE", "Italy": "IT"}},
+		{"reject duplicate value of existing key", RejectDuplicates, "France", "DE", ErrDuplicateValue,
+			map[string]string{"Germany": "DE", "France": "FR", "Italy": "IT"}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			bm := codes(tt.policy)
+			if err := bm.Put(tt.key, tt.val); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Put() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := maps.Collect(bm.All()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("All() = %v, want %v", got, tt.want)
+			}
+			// The reverse direction has to contain exactly the swapped pairs.
+			wantInverse := make(map[string]string)
+			for k, v := range tt.want {
+				wantInverse[v] = k
+			}
+			if got := maps.Collect(bm.Inverse().All()); !reflect.DeepEqual(got, wantInverse) {
+				t.Errorf("Inverse().All() = %v, want %v", got, wantInverse)
+			}
+			if bm.Len() != uint(len(tt.want)) {
+				t.Errorf("Len() = %v, want %v", bm.Len(), len(tt.want))
+			}
+		})
+	}
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		bm := NewBiMap[string, func()](0, ReplaceDuplicates)
+		if err := bm.Put("a", func() {}); err == nil {
+			t.Errorf("Put() expected error for unhashable value")
+		}
+		if !bm.IsEmpty() {
+			t.Errorf("Put() with error changed the BiMap")
+		}
+
+		// The value is inserted first and has to be removed again if the key can not be hashed.
+		keyErr := NewBiMap[func(), string](0, ReplaceDuplicates)
+		if err := keyErr.Put(func() {}, "a"); err == nil {
+			t.Errorf("Put() expected error for unhashable key")
+		}
+		if found, _ := keyErr.ContainsValue("a"); found || !keyErr.IsEmpty() {
+			t.Errorf("Put() with error left the value in the BiMap")
+		}
+	})
+}
+
+func TestBiMap_GetRemove(t *testing.T) {
+	type testCase struct {
+		name      string
+		key       string
+		val       string
+		wantFound bool
+	}
+	tests := []testCase{
+		{"existing pair", "France", "FR", true},
+		{"missing pair", "Spain", "ES", false},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			bm := codes(RejectDuplicates)
+			if val, found, err := bm.GetByKey(tt.key); found != tt.wantFound || err != nil || (found && val != tt.val) {
+				t.Errorf("GetByKey() = %v, %v, %v, want %v, %v", val, found, err, tt.val, tt.wantFound)
+			}
+			if key, found, err := bm.GetByValue(tt.val); found != tt.wantFound || err != nil || (found && key != tt.key) {
+				t.Errorf("GetByValue() = %v, %v, %v, want %v, %v", key, found, err, tt.key, tt.wantFound)
+			}
+			if found, _ := bm.ContainsValue(tt.val); found != tt.wantFound {
+				t.Errorf("ContainsValue() = %v, want %v", found, tt.wantFound)
+			}
+
+			byKey := codes(RejectDuplicates)
+			if val, found, _ := byKey.RemoveByKey(tt.key); found != tt.wantFound || (found && val != tt.val) {
+				t.Errorf("RemoveByKey() = %v, %v, want %v, %v", val, found, tt.val, tt.wantFound)
+			}
+			if found, _ := byKey.ContainsValue(tt.val); found {
+				t.Errorf("RemoveByKey() left the value behind")
+			}
+
+			byValue := codes(RejectDuplicates)
+			if key, found, _ := byValue.RemoveByValue(tt.val); found != tt.wantFound || (found && key != tt.key) {
+				t.Errorf("RemoveByValue() = %v, %v, want %v, %v", key, found, tt.key, tt.wantFound)
+			}
+			if found, _ := byValue.ContainsKey(tt.key); found {
+				t.Errorf("RemoveByValue() left the key behind")
+			}
+		})
+	}
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	name := "inverse is a view"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		bm := codes(RejectDuplicates)
+		inv := bm.Inverse()
+		if key, _, _ := inv.GetByKey("IT"); key != "Italy" {
+			t.Errorf("Inverse().GetByKey() = %v, want Italy", key)
+		}
+		_ = inv.Put("ES", "Spain")
+		if val, found, _ := bm.GetByKey("Spain"); !found || val != "ES" {
+			t.Errorf("Put() on Inverse() not visible in BiMap")
+		}
+		if err := inv.Put("XX", "Italy"); !errors.Is(err, ErrDuplicateValue) {
+			t.Errorf("Inverse() does not keep the policy, Put() error = %v", err)
+		}
+		_, _, _ = bm.RemoveByKey("Germany")
+		if found, _ := inv.ContainsKey("DE"); found {
+			t.Errorf("RemoveByKey() on BiMap not visible in Inverse()")
+		}
+		if inv.Inverse().Len() != bm.Len() {
+			t.Errorf("Inverse().Inverse().Len() = %v, want %v", inv.Inverse().Len(), bm.Len())
+		}
+		bm.Clear()
+		if !inv.IsEmpty() || len(inv.Keys()) != 0 || len(inv.Values()) != 0 {
+			t.Errorf("Clear() on BiMap not visible in Inverse()")
+		}
+	})
+}
+
+type caseInsensitiveHasher struct{}
+
+func (caseInsensitiveHasher) Hash(key string, seed uint32) (uint32, error) {
+	return hashMap.ComparableHasher[string]{}.Hash(strings.ToLower(key), seed)
+}
+
+func (caseInsensitiveHasher) Equal(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+func TestNewBiMapWithHashers(t *testing.T) {
+	name := "case-insensitive values"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		bm := NewBiMapWithHashers[int, string](0, RejectDuplicates, nil, caseInsensitiveHasher{})
+		_ = bm.Put(1, "Go")
+		if err := bm.Put(2, "GO"); !errors.Is(err, ErrDuplicateValue) {
+			t.Errorf("Put() error = %v, want %v", err, ErrDuplicateValue)
+		}
+		if key, found, _ := bm.GetByValue("go"); !found || key != 1 {
+			t.Errorf("GetByValue() = %v, %v, want 1, true", key, found)
+		}
+	})
+}
@@ -0,0 +1,287 @@
+package cache
+
+import (
+	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+	"dsa/datastructures/hashMap"
+)
+
+/*
+Adaptive replacement cache (ARC) by Megiddo and Modha with a fixed capacity c. Every operation runs in O(1).
+
+ARC keeps four LRU lists, all ordered from the most (Head) to the least (tail) recently used:
+- T1: cached pairs which were used once recently (recency)
+- T2: cached pairs which were used at least twice recently (frequency)
+- B1: ghost keys of pairs recently evicted from T1, without values
+- B2: ghost keys of pairs recently evicted from T2, without values
+
+T1 and T2 share the c slots of the cache, and the target size p of T1 decides which of them has to give up a slot.
+A Put of a ghost key means that the pair was evicted too early:
+- a hit in B1 means T1 was too small, so p grows
+- a hit in B2 means T2 was too small, so p shrinks.
+
+This way ARC adapts by itself to workloads which favour recency or frequency. A single scan over many keys only
+passes through T1 and never pushes the frequently used pairs out of T2, which a plain LRU cache would do.
+
+Get of a ghost key is a miss and does not adapt p, because there is no value to cache. Only Put does.
+*/
+
+type arcList uint8
+
+const (
+	arcT1 arcList = iota
+	arcT2
+	arcB1
+	arcB2
+)
+
+type arcEntry[V any] struct {
+	value V
+	in    arcList
+}
+
+type ARC[K, V any] struct {
+	capacity uint
+	// p is the target size of T1.
+	p     uint
+	index *hashMap.HashMap[K, *doublyLinkedListHM.Node[K, arcEntry[V]]]
+	// lists are T1, T2, B1 and B2, indexed by arcList.
+	lists   [4]*list[K, arcEntry[V]]
+	onEvict func(key K, val V)
+}
+
+// NewARC creates a new ARC cache which holds up to capacity pairs and remembers up to capacity ghost keys. Runtime O(capacity)
+//
+// A capacity of 0 is treated as 1.
+//
+// opts - Optional configuration of the index HashMap, e.g. hashMap.WithHasher.
+func NewARC[K, V any](capacity uint, opts ...hashMap.Option[K]) *ARC[K, V] {
+	return NewARCWithEvict[K, V](capacity, nil, opts...)
+}
+
+// NewARCWithEvict creates a new ARC cache, which calls onEvict for every pair it evicts. Runtime O(capacity)
+//
+// onEvict is only called for pairs which are pushed out by Put, not for Remove or Clear.
+// The key of an evicted pair may stay in the cache as a ghost key, but its value is dropped.
+func NewARCWithEvict[K, V any](capacity uint, onEvict func(key K, val V), opts ...hashMap.Option[K]) *ARC[K, V] {
+	capacity = max(capacity, 1)
+	c := &ARC[K, V]{
+		capacity: capacity,
+		index:    hashMap.NewHashMap[K, *doublyLinkedListHM.Node[K, arcEntry[V]]](capacity*2, opts...),
+		onEvict:  onEvict,
+	}
+	for i := range c.lists {
+		c.lists[i] = newList[K, arcEntry[V]]()
+	}
+	return c
+}
+
+// Get value by key and count it as a use, which moves it to T2. Runtime O(1)
+//
+// Returns the value and whether it was found.
+func (c *ARC[K, V]) Get(key K) (val V, found bool, err error) {
+	node, err := c.cached(key)
+	if err != nil || node == nil {
+		return val, false, err
+	}
+
+	c.move(node, arcT2)
+	return node.Value.value, true, nil
+}
+
+// Peek returns the value of key without counting it as a use. Runtime O(1)
+func (c *ARC[K, V]) Peek(key K) (val V, found bool, err error) {
+	node, err := c.cached(key)
+	if err != nil || node == nil {
+		return val, false, err
+	}
+	return node.Value.value, true, nil
+}
+
+// Put inserts a key value pair or replaces the value of key, and counts it as a use. Runtime O(1)
+//
+// A new key is cached in T1. A cached key and a ghost key are cached in T2.
+// If the cache is full, the least recently used pair of T1 or T2 is evicted, depending on the target size of T1.
+//
+// Returns whether a pair was evicted.
+func (c *ARC[K, V]) Put(key K, val V) (evicted bool, err error) {
+	nodePtr, err := c.index.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	if nodePtr != nil {
+		node := *nodePtr
+		switch node.Value.in {
+		case arcT1, arcT2:
+			node.Value.value = val
+			c.move(node, arcT2)
+			return false, nil
+		case arcB1:
+			c.p = min(c.capacity, c.p+max(c.len(arcB2)/c.len(arcB1), 1))
+		case arcB2:
+			c.p -= min(c.p, max(c.len(arcB1)/c.len(arcB2), 1))
+		}
+
+		if c.Len() >= c.capacity {
+			if evicted, err = c.replace(node.Value.in == arcB2); err != nil {
+				return evicted, err
+			}
+		}
+		node.Value.value = val
+		c.move(node, arcT2)
+		return evicted, nil
+	}
+
+	if c.len(arcT1)+c.len(arcB1) >= c.capacity {
+		if c.len(arcT1) < c.capacity {
+			if err := c.drop(c.lists[arcB1].back()); err != nil {
+				return false, err
+			}
+			if c.Len() >= c.capacity {
+				if evicted, err = c.replace(false); err != nil {
+					return evicted, err
+				}
+			}
+		} else {
+			// T1 fills the whole cache, so there are no ghosts in B1 and its oldest pair is dropped completely.
+			node := c.lists[arcT1].back()
+			if err := c.drop(node); err != nil {
+				return false, err
+			}
+			c.evicted(node)
+			evicted = true
+		}
+	} else if total := c.Len() + c.len(arcB1) + c.len(arcB2); total >= c.capacity {
+		if total >= 2*c.capacity {
+			if err := c.drop(c.lists[arcB2].back()); err != nil {
+				return false, err
+			}
+		}
+		if c.Len() >= c.capacity {
+			if evicted, err = c.replace(false); err != nil {
+				return evicted, err
+			}
+		}
+	}
+
+	node := &doublyLinkedListHM.Node[K, arcEntry[V]]{Key: key, Value: arcEntry[V]{value: val, in: arcT1}}
+	if err := c.index.Insert(key, node); err != nil {
+		return evicted, err
+	}
+	c.lists[arcT1].pushFront(node)
+	return evicted, nil
+}
+
+// Remove key value pair by key without calling the eviction callback. Runtime O(1)
+//
+// A ghost key of key is removed as well, but does not count as found.
+//
+// Returns the value and whether it was found.
+func (c *ARC[K, V]) Remove(key K) (val V, found bool, err error) {
+	nodePtr, err := c.index.Remove(key)
+	if err != nil || nodePtr == nil {
+		return val, false, err
+	}
+
+	node := *nodePtr
+	c.lists[node.Value.in].remove(node)
+	if node.Value.in == arcB1 || node.Value.in == arcB2 {
+		return val, false, nil
+	}
+	return node.Value.value, true, nil
+}
+
+// Contains checks if key is cached without counting it as a use. Ghost keys are not cached. Runtime O(1)
+func (c *ARC[K, V]) Contains(key K) (bool, error) {
+	node, err := c.cached(key)
+	return node != nil, err
+}
+
+// Len returns the number of cached pairs without the ghost keys. Runtime O(1)
+func (c *ARC[K, V]) Len() uint {
+	return c.len(arcT1) + c.len(arcT2)
+}
+
+// Capacity returns the maximum number of cached pairs. Runtime O(1)
+func (c *ARC[K, V]) Capacity() uint {
+	return c.capacity
+}
+
+// Keys returns all cached keys, first those of T1 and then those of T2, each from the most to the least recently used.
+// Runtime O(n)
+func (c *ARC[K, V]) Keys() []K {
+	return append(c.lists[arcT1].keys(), c.lists[arcT2].keys()...)
+}
+
+// Clear the cache and forget all ghost keys without calling the eviction callback. Runtime O(1)
+func (c *ARC[K, V]) Clear() {
+	c.index.Clear()
+	for i := range c.lists {
+		c.lists[i] = newList[K, arcEntry[V]]()
+	}
+	c.p = 0
+}
+
+// cached returns the node of key if key is in T1 or T2, or nil otherwise.
+func (c *ARC[K, V]) cached(key K) (*doublyLinkedListHM.Node[K, arcEntry[V]], error) {
+	node, err := c.index.Get(key)
+	if err != nil || node == nil {
+		return nil, err
+	}
+	if in := (*node).Value.in; in != arcT1 && in != arcT2 {
+		return nil, nil
+	}
+	return *node, nil
+}
+
+// replace makes room for one pair by moving the least recently used pair of T1 or T2 to its ghost list B1 or B2,
+// depending on the target size p of T1. inB2 reports whether the key being put is a ghost key from B2.
+//
+// Returns whether a pair was evicted.
+func (c *ARC[K, V]) replace(inB2 bool) (bool, error) {
+	t1 := c.len(arcT1)
+	from, to := arcT2, arcB2
+	if t1 > 0 && ((inB2 && t1 == c.p) || t1 > c.p || c.len(arcT2) == 0) {
+		from, to = arcT1, arcB1
+	}
+
+	node := c.lists[from].back()
+	if node == nil {
+		return false, nil
+	}
+	c.evicted(node)
+	node.Value.value = *new(V)
+	c.move(node, to)
+	return true, nil
+}
+
+// evicted calls the eviction callback for node.
+func (c *ARC[K, V]) evicted(node *doublyLinkedListHM.Node[K, arcEntry[V]]) {
+	if c.onEvict != nil {
+		c.onEvict(node.Key, node.Value.value)
+	}
+}
+
+// move moves node to the Head of the list to.
+func (c *ARC[K, V]) move(node *doublyLinkedListHM.Node[K, arcEntry[V]], to arcList) {
+	c.lists[node.Value.in].remove(node)
+	node.Value.in = to
+	c.lists[to].pushFront(node)
+}
+
+// drop removes node completely, from its list and from the index.
+func (c *ARC[K, V]) drop(node *doublyLinkedListHM.Node[K, arcEntry[V]]) error {
+	if node == nil {
+		return nil
+	}
+	if _, err := c.index.Remove(node.Key); err != nil {
+		return err
+	}
+	c.lists[node.Value.in].remove(node)
+	return nil
+}
+
+// len returns the length of the list l.
+func (c *ARC[K, V]) len(l arcList) uint {
+	return c.lists[l].Size
+}
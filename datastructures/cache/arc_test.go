@@ -0,0 +1,168 @@
+package cache
+
+import (
+	"dsa/util/sugar"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestARC_EvictionOrder(t *testing.T) {
+	type testCase struct {
+		name        string
+		capacity    uint
+		ops         []op
+		wantEvicted []int
+		wantKeys    []int
+		wantP       uint
+	}
+	tests := []testCase{
+		{"no eviction below capacity", 3, []op{put(1, 1), put(2, 2)}, []int{}, []int{2, 1}, 0},
+		{"T1 full drops oldest", 2, []op{put(1, 1), put(2, 2), put(3, 3)}, []int{1}, []int{3, 2}, 0},
+		{"get moves to T2", 2, []op{put(1, 1), get(1), put(2, 2), put(3, 3)}, []int{2}, []int{3, 1}, 0},
+		{"peek does not move", 2, []op{put(1, 1), peek(1), put(2, 2), put(3, 3)}, []int{1}, []int{3, 2}, 0},
+		{"ghost hit in B1 grows p", 2, []op{put(1, 1), get(1), put(2, 2), put(3, 3), put(2, 2)}, []int{2, 1}, []int{3, 2}, 1},
+		{
+			"ghost hit in B2 shrinks p",
+			2,
+			[]op{put(1, 1), get(1), put(2, 2), put(3, 3), put(2, 2), put(1, 1)},
+			[]int{2, 1, 3},
+			[]int{1, 2},
+			0,
+		},
+		{"get of ghost is a miss", 2, []op{put(1, 1), get(1), put(2, 2), put(3, 3), get(2)}, []int{2}, []int{3, 1}, 0},
+		{"removed ghost is new again", 2, []op{put(1, 1), get(1), put(2, 2), put(3, 3), remove(2), put(2, 2)}, []int{2, 3}, []int{2, 1}, 0},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			evicted := make([]int, 0)
+			c := NewARCWithEvict[int, int](tt.capacity, func(key, val int) {
+				if key != val {
+					t.Errorf("onEvict() got key %v with value %v", key, val)
+				}
+				evicted = append(evicted, key)
+			})
+			apply(c, tt.ops)
+			if !reflect.DeepEqual(evicted, tt.wantEvicted) {
+				t.Errorf("evicted = %v, want %v", evicted, tt.wantEvicted)
+			}
+			if got := c.Keys(); !reflect.DeepEqual(got, tt.wantKeys) {
+				t.Errorf("Keys() = %v, want %v", got, tt.wantKeys)
+			}
+			if c.p != tt.wantP {
+				t.Errorf("p = %v, want %v", c.p, tt.wantP)
+			}
+		})
+	}
+}
+
+func TestARC_ScanResistance(t *testing.T) {
+	type testCase struct {
+		name  string
+		cache Cache[int, int]
+		want  bool
+	}
+	tests := []testCase{
+		{"ARC keeps frequently used pairs", NewARC[int, int](3), true},
+		{"LRU loses frequently used pairs", NewLRU[int, int](3), false},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			apply(tt.cache, []op{put(1, 1), get(1), put(2, 2), get(2)})
+			for k := 10; k < 20; k++ {
+				apply(tt.cache, []op{put(k, k)})
+			}
+			for _, k := range []int{1, 2} {
+				if found, _ := tt.cache.Contains(k); found != tt.want {
+					t.Errorf("Contains(%v) = %v, want %v", k, found, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestARC_Invariants(t *testing.T) {
+	type testCase struct {
+		name     string
+		capacity uint
+		keys     int
+	}
+	tests := []testCase{
+		{"small key space", 4, 8},
+		{"large key space", 16, 200},
+		{"capacity 1", 1, 5},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			rnd := rand.New(rand.NewSource(42))
+			c := NewARC[int, int](tt.capacity)
+			for i := range 5000 {
+				k := rnd.Intn(tt.keys)
+				switch rnd.Intn(10) {
+				case 0:
+					_, _, _ = c.Remove(k)
+				case 1, 2, 3:
+					_, _, _ = c.Get(k)
+				default:
+					_, _ = c.Put(k, k)
+				}
+
+				t1, t2, b1, b2 := c.len(arcT1), c.len(arcT2), c.len(arcB1), c.len(arcB2)
+				if t1+t2 > tt.capacity || t1+b1 > tt.capacity || t1+t2+b1+b2 > 2*tt.capacity || c.p > tt.capacity {
+					t.Fatalf("step %v: |T1|=%v |T2|=%v |B1|=%v |B2|=%v p=%v break the invariants", i, t1, t2, b1, b2, c.p)
+				}
+				if c.index.Size != t1+t2+b1+b2 {
+					t.Fatalf("step %v: index has %v keys, lists have %v", i, c.index.Size, t1+t2+b1+b2)
+				}
+			}
+		})
+	}
+}
+
+func TestARC_Values(t *testing.T) {
+	name := "get, peek, put and remove return values"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		c := NewARC[string, int](2)
+		_, _ = c.Put("a", 1)
+		_, _ = c.Put("a", 2)
+		if got, found, _ := c.Get("a"); !found || got != 2 {
+			t.Errorf("Get() = %v, %v, want 2, true", got, found)
+		}
+		if got, found, _ := c.Peek("b"); found || got != 0 {
+			t.Errorf("Peek() = %v, %v, want 0, false", got, found)
+		}
+		_, _ = c.Put("b", 3)
+		if evicted, _ := c.Put("c", 4); !evicted {
+			t.Errorf("Put() did not evict")
+		}
+		if found, _ := c.Contains("b"); found {
+			t.Errorf("Contains() found evicted key")
+		}
+		if got, found, _ := c.Remove("a"); !found || got != 2 {
+			t.Errorf("Remove() = %v, %v, want 2, true", got, found)
+		}
+		c.Clear()
+		if c.Len() != 0 || len(c.Keys()) != 0 || c.index.Size != 0 {
+			t.Errorf("Clear() did not empty the cache")
+		}
+	})
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		c := NewARC[func(), int](1)
+		if _, err := c.Put(func() {}, 1); err == nil {
+			t.Errorf("Put() expected error for unhashable key")
+		}
+		if c.Len() != 0 {
+			t.Errorf("Len() = %v, want 0", c.Len())
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package cache
+
+/*
+Caches with a fixed capacity and different eviction policies:
+- LRU evicts the least recently used pair.
+- LFU evicts the least frequently used pair, and the least recently used of them on a tie.
+- ARC adapts between recency and frequency by remembering the keys of recently evicted pairs.
+
+All of them index their pairs with a hashMap.HashMap and keep them in lists of doublyLinkedListHM nodes,
+so that every operation runs in O(1). Use Replay to compare the hit ratio of the policies on a trace of keys.
+*/
+
+// Cache is the common interface of all cache policies.
+type Cache[K, V any] interface {
+	// Get returns the value of key and whether it was found, and counts it as a use of key.
+	Get(key K) (val V, found bool, err error)
+	// Peek returns the value of key and whether it was found, without counting it as a use.
+	Peek(key K) (val V, found bool, err error)
+	// Put inserts or replaces the value of key and counts it as a use. If the cache is full, a pair is evicted.
+	Put(key K, val V) (evicted bool, err error)
+	// Remove removes key without calling the eviction callback.
+	Remove(key K) (val V, found bool, err error)
+	// Contains checks if key is cached, without counting it as a use.
+	Contains(key K) (bool, error)
+	// Len returns the number of cached pairs.
+	Len() uint
+	// Capacity returns the maximum number of cached pairs.
+	Capacity() uint
+	// Keys returns all cached keys. The order depends on the policy.
+	Keys() []K
+	// Clear removes all pairs without calling the eviction callback.
+	Clear()
+}
+
+var (
+	_ Cache[int, int] = (*LRU[int, int])(nil)
+	_ Cache[int, int] = (*LFU[int, int])(nil)
+	_ Cache[int, int] = (*ARC[int, int])(nil)
+)
@@ -0,0 +1,235 @@
+package cache
+
+import (
+	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+	"dsa/datastructures/hashMap"
+)
+
+/*
+Least frequently used (LFU) cache with a fixed capacity. Every operation runs in O(1).
+
+Instead of a heap ordered by use count, which would cost O(log n) per use, the pairs are grouped by their use count
+(frequency) into one list per frequency:
+- index: key -> node of the pair
+- freqs: frequency -> list of all pairs used that often, from the most (Head) to the least (tail) recently used.
+
+A use moves the node from the list of frequency f to the Head of the list of f+1.
+minFreq remembers the lowest frequency of any pair, so the pair to evict is always the tail of freqs[minFreq].
+minFreq only changes in two ways: a new pair resets it to 1, and a use can raise it by exactly 1
+when the list of minFreq becomes empty.
+*/
+
+type lfuEntry[V any] struct {
+	value V
+	freq  uint
+}
+
+type LFU[K, V any] struct {
+	capacity uint
+	index    *hashMap.HashMap[K, *doublyLinkedListHM.Node[K, lfuEntry[V]]]
+	freqs    *hashMap.HashMap[uint, *list[K, lfuEntry[V]]]
+	minFreq  uint
+	size     uint
+	onEvict  func(key K, val V)
+}
+
+// NewLFU creates a new LFU cache which holds up to capacity pairs. Runtime O(capacity)
+//
+// A capacity of 0 is treated as 1.
+//
+// opts - Optional configuration of the index HashMap, e.g. hashMap.WithHasher.
+func NewLFU[K, V any](capacity uint, opts ...hashMap.Option[K]) *LFU[K, V] {
+	return NewLFUWithEvict[K, V](capacity, nil, opts...)
+}
+
+// NewLFUWithEvict creates a new LFU cache, which calls onEvict for every pair it evicts. Runtime O(capacity)
+//
+// onEvict is only called for pairs which are pushed out by Put, not for Remove or Clear.
+func NewLFUWithEvict[K, V any](capacity uint, onEvict func(key K, val V), opts ...hashMap.Option[K]) *LFU[K, V] {
+	capacity = max(capacity, 1)
+	return &LFU[K, V]{
+		capacity: capacity,
+		index:    hashMap.NewHashMap[K, *doublyLinkedListHM.Node[K, lfuEntry[V]]](capacity, opts...),
+		freqs:    hashMap.NewComparableHashMap[uint, *list[K, lfuEntry[V]]](0),
+		onEvict:  onEvict,
+	}
+}
+
+// Get value by key and count it as a use. Runtime O(1)
+//
+// Returns the value and whether it was found.
+func (c *LFU[K, V]) Get(key K) (val V, found bool, err error) {
+	node, err := c.index.Get(key)
+	if err != nil || node == nil {
+		return val, false, err
+	}
+
+	if err := c.use(*node); err != nil {
+		return val, false, err
+	}
+	return (*node).Value.value, true, nil
+}
+
+// Peek returns the value of key without counting it as a use. Runtime O(1)
+func (c *LFU[K, V]) Peek(key K) (val V, found bool, err error) {
+	node, err := c.index.Get(key)
+	if err != nil || node == nil {
+		return val, false, err
+	}
+	return (*node).Value.value, true, nil
+}
+
+// Put inserts a key value pair or replaces the value of key, and counts it as a use. Runtime O(1)
+//
+// If the cache is full, the least frequently used pair is evicted before the new pair is inserted.
+// A new pair starts with a frequency of 1.
+//
+// Returns whether a pair was evicted.
+func (c *LFU[K, V]) Put(key K, val V) (evicted bool, err error) {
+	// Looking the key up first makes sure that it can be hashed before anything is evicted.
+	node, err := c.index.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	if node != nil {
+		(*node).Value.value = val
+		return false, c.use(*node)
+	}
+
+	if c.size >= c.capacity {
+		if err := c.evict(); err != nil {
+			return false, err
+		}
+		evicted = true
+	}
+
+	newNode := &doublyLinkedListHM.Node[K, lfuEntry[V]]{Key: key, Value: lfuEntry[V]{value: val, freq: 1}}
+	if err := c.index.Insert(key, newNode); err != nil {
+		return evicted, err
+	}
+	if err := c.pushFreq(newNode); err != nil {
+		return evicted, err
+	}
+	c.minFreq = 1
+	c.size++
+	return evicted, nil
+}
+
+// Remove key value pair by key without calling the eviction callback. Runtime O(1)
+//
+// Returns the value and whether it was found.
+func (c *LFU[K, V]) Remove(key K) (val V, found bool, err error) {
+	node, err := c.index.Remove(key)
+	if err != nil || node == nil {
+		return val, false, err
+	}
+
+	// If this was the last pair of minFreq, minFreq is outdated now. It does not matter, because the cache has room
+	// for one more pair, so the next Put does not evict and resets minFreq to 1.
+	if _, err := c.unlinkFreq(*node); err != nil {
+		return val, false, err
+	}
+	c.size--
+	return (*node).Value.value, true, nil
+}
+
+// Contains checks if key is cached without counting it as a use. Runtime O(1)
+func (c *LFU[K, V]) Contains(key K) (bool, error) {
+	return c.index.ContainsKey(key)
+}
+
+// Len returns the number of cached pairs. Runtime O(1)
+func (c *LFU[K, V]) Len() uint {
+	return c.size
+}
+
+// Capacity returns the maximum number of cached pairs. Runtime O(1)
+func (c *LFU[K, V]) Capacity() uint {
+	return c.capacity
+}
+
+// Keys returns all keys in no particular order. Runtime O(n)
+func (c *LFU[K, V]) Keys() []K {
+	return c.index.Keys()
+}
+
+// Frequency returns how often key was used, or 0 if key is not cached. Runtime O(1)
+func (c *LFU[K, V]) Frequency(key K) (uint, error) {
+	node, err := c.index.Get(key)
+	if err != nil || node == nil {
+		return 0, err
+	}
+	return (*node).Value.freq, nil
+}
+
+// Clear the cache without calling the eviction callback. Runtime O(1)
+func (c *LFU[K, V]) Clear() {
+	c.index.Clear()
+	c.freqs.Clear()
+	c.minFreq = 0
+	c.size = 0
+}
+
+// use moves node from the list of its frequency to the list of the next frequency.
+func (c *LFU[K, V]) use(node *doublyLinkedListHM.Node[K, lfuEntry[V]]) error {
+	emptied, err := c.unlinkFreq(node)
+	if err != nil {
+		return err
+	}
+	if emptied && c.minFreq == node.Value.freq {
+		c.minFreq++
+	}
+
+	node.Value.freq++
+	return c.pushFreq(node)
+}
+
+// pushFreq pushes node to the Head of the list of its frequency, creating the list if needed.
+func (c *LFU[K, V]) pushFreq(node *doublyLinkedListHM.Node[K, lfuEntry[V]]) error {
+	l, _, err := c.freqs.GetOrInsertFunc(node.Value.freq, newList[K, lfuEntry[V]])
+	if err != nil {
+		return err
+	}
+	(*l).pushFront(node)
+	return nil
+}
+
+// unlinkFreq removes node from the list of its frequency and drops the list if it became empty.
+//
+// Returns whether the list became empty.
+func (c *LFU[K, V]) unlinkFreq(node *doublyLinkedListHM.Node[K, lfuEntry[V]]) (emptied bool, err error) {
+	l, err := c.freqs.Get(node.Value.freq)
+	if err != nil {
+		return false, err
+	}
+
+	(*l).remove(node)
+	if (*l).Size > 0 {
+		return false, nil
+	}
+	_, err = c.freqs.Remove(node.Value.freq)
+	return true, err
+}
+
+// evict removes the least recently used pair of the lowest frequency and calls the eviction callback.
+func (c *LFU[K, V]) evict() error {
+	l, err := c.freqs.Get(c.minFreq)
+	if err != nil {
+		return err
+	}
+
+	node := (*l).back()
+	if _, err := c.index.Remove(node.Key); err != nil {
+		return err
+	}
+	if _, err := c.unlinkFreq(node); err != nil {
+		return err
+	}
+	c.size--
+
+	if c.onEvict != nil {
+		c.onEvict(node.Key, node.Value.value)
+	}
+	return nil
+}
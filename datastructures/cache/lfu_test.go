@@ -0,0 +1,153 @@
+package cache
+
+import (
+	"dsa/datastructures/hashMap"
+	"dsa/util/sugar"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestLFU_EvictionOrder(t *testing.T) {
+	type testCase struct {
+		name        string
+		capacity    uint
+		ops         []op
+		wantEvicted []int
+		wantKeys    []int
+	}
+	tests := []testCase{
+		{"no eviction below capacity", 3, []op{put(1, 1), put(2, 2)}, []int{}, []int{1, 2}},
+		{"evict least frequently used", 2, []op{put(1, 1), put(2, 2), get(1), put(3, 3)}, []int{2}, []int{1, 3}},
+		{"tie evicts least recently used", 2, []op{put(1, 1), put(2, 2), put(3, 3)}, []int{1}, []int{2, 3}},
+		{"tie on higher frequency", 2, []op{put(1, 1), put(2, 2), get(1), get(2), put(3, 3)}, []int{1}, []int{2, 3}},
+		{"peek does not count", 2, []op{put(1, 1), put(2, 2), peek(1), put(3, 3)}, []int{1}, []int{2, 3}},
+		{"put of existing key counts", 2, []op{put(1, 1), put(2, 2), put(1, 1), put(3, 3)}, []int{2}, []int{1, 3}},
+		{"new pair is evicted before old frequent pairs", 2, []op{put(1, 1), get(1), put(2, 2), put(3, 3), put(4, 4)}, []int{2, 3}, []int{1, 4}},
+		{"remove last pair of lowest frequency", 2, []op{put(1, 1), get(1), put(2, 2), remove(2), put(3, 3), put(4, 4)}, []int{3}, []int{1, 4}},
+		{
+			"mixed workload",
+			3,
+			[]op{put(1, 1), get(1), get(1), put(2, 2), get(2), put(3, 3), put(4, 4), get(4), get(4), get(4), put(5, 5), get(5), put(6, 6)},
+			[]int{3, 2, 5},
+			[]int{1, 4, 6},
+		},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			evicted := make([]int, 0)
+			c := NewLFUWithEvict[int, int](tt.capacity, func(key, val int) {
+				evicted = append(evicted, key)
+			})
+			apply(c, tt.ops)
+			if !reflect.DeepEqual(evicted, tt.wantEvicted) {
+				t.Errorf("evicted = %v, want %v", evicted, tt.wantEvicted)
+			}
+			got := c.Keys()
+			slices.Sort(got)
+			if !reflect.DeepEqual(got, tt.wantKeys) {
+				t.Errorf("Keys() = %v, want %v", got, tt.wantKeys)
+			}
+			if c.Len() != uint(len(tt.wantKeys)) {
+				t.Errorf("Len() = %v, want %v", c.Len(), len(tt.wantKeys))
+			}
+		})
+	}
+}
+
+func TestLFU_Frequency(t *testing.T) {
+	type testCase struct {
+		name string
+		ops  []op
+		key  int
+		want uint
+	}
+	tests := []testCase{
+		{"missing key", []op{put(1, 1)}, 2, 0},
+		{"new key", []op{put(1, 1)}, 1, 1},
+		{"get and put count", []op{put(1, 1), get(1), put(1, 2), get(1)}, 1, 4},
+		{"peek does not count", []op{put(1, 1), peek(1)}, 1, 1},
+		{"removed key", []op{put(1, 1), get(1), remove(1)}, 1, 0},
+		{"put after remove starts again", []op{put(1, 1), get(1), remove(1), put(1, 1)}, 1, 1},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			c := NewLFU[int, int](4)
+			apply(c, tt.ops)
+			if got, err := c.Frequency(tt.key); got != tt.want || err != nil {
+				t.Errorf("Frequency() = %v, %v, want %v, nil", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestLFU_GetAllocs(t *testing.T) {
+	name := "get does not allocate if the list of the next frequency exists"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		c := NewLFU[int, int](200, hashMap.WithHasher[int](hashMap.ComparableHasher[int]{}))
+		for i := range 200 {
+			_, _ = c.Put(i, i)
+		}
+		// Creates the list of frequency 2, every following Get moves another key from frequency 1 into it.
+		_, _, _ = c.Get(0)
+		next := 1
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _, _ = c.Get(next)
+			next++
+		})
+		if allocs != 0 {
+			t.Errorf("Get() allocated %v times, want 0", allocs)
+		}
+	})
+}
+
+func TestLFU_Values(t *testing.T) {
+	name := "get, peek, put and remove return values"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		c := NewLFU[string, int](2)
+		_, _ = c.Put("a", 1)
+		_, _ = c.Put("a", 2)
+		if got, found, _ := c.Get("a"); !found || got != 2 {
+			t.Errorf("Get() = %v, %v, want 2, true", got, found)
+		}
+		if got, found, _ := c.Peek("b"); found || got != 0 {
+			t.Errorf("Peek() = %v, %v, want 0, false", got, found)
+		}
+		_, _ = c.Put("b", 3)
+		if evicted, _ := c.Put("c", 4); !evicted {
+			t.Errorf("Put() did not evict")
+		}
+		if found, _ := c.Contains("b"); found {
+			t.Errorf("Contains() found evicted key")
+		}
+		if got, found, _ := c.Remove("a"); !found || got != 2 {
+			t.Errorf("Remove() = %v, %v, want 2, true", got, found)
+		}
+		c.Clear()
+		if c.Len() != 0 || len(c.Keys()) != 0 {
+			t.Errorf("Clear() did not empty the cache")
+		}
+		if evicted, _ := c.Put("d", 5); evicted {
+			t.Errorf("Put() evicted after Clear()")
+		}
+	})
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		c := NewLFU[func(), int](1)
+		if _, err := c.Put(func() {}, 1); err == nil {
+			t.Errorf("Put() expected error for unhashable key")
+		}
+		if c.Len() != 0 {
+			t.Errorf("Len() = %v, want 0", c.Len())
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package cache
+
+import "dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+
+// list is a doublyLinkedListHM.LinkedList which also remembers its tail, so that all policies can find and evict
+// their oldest node in O(1). The Head is the newest node.
+type list[K, V any] struct {
+	*doublyLinkedListHM.LinkedList[K, V]
+	tail *doublyLinkedListHM.Node[K, V]
+}
+
+func newList[K, V any]() *list[K, V] {
+	return &list[K, V]{LinkedList: &doublyLinkedListHM.LinkedList[K, V]{}}
+}
+
+// pushFront pushes node as the newest node. Runtime O(1)
+func (l *list[K, V]) pushFront(node *doublyLinkedListHM.Node[K, V]) {
+	l.PushNode(node)
+	if l.tail == nil {
+		l.tail = node
+	}
+}
+
+// remove unlinks node, which must be part of the list. Runtime O(1)
+func (l *list[K, V]) remove(node *doublyLinkedListHM.Node[K, V]) {
+	if l.tail == node {
+		l.tail = node.Prev
+	}
+	l.RemoveNode(node)
+}
+
+// moveToFront makes node, which must be part of the list, the newest node. Runtime O(1)
+func (l *list[K, V]) moveToFront(node *doublyLinkedListHM.Node[K, V]) {
+	if l.Head == node {
+		return
+	}
+	l.remove(node)
+	l.pushFront(node)
+}
+
+// back returns the oldest node or nil if the list is empty. Runtime O(1)
+func (l *list[K, V]) back() *doublyLinkedListHM.Node[K, V] {
+	return l.tail
+}
+
+// keys returns all keys from the newest to the oldest. Runtime O(n)
+func (l *list[K, V]) keys() []K {
+	keys := make([]K, 0, l.Size)
+	for node := l.Head; node != nil; node = node.Next {
+		keys = append(keys, node.Key)
+	}
+	return keys
+}
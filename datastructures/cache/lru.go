@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+	"dsa/datastructures/hashMap"
+)
+
+/*
+Least recently used (LRU) cache with a fixed capacity.
+
+It combines the two ingredients of an LRU cache from this repository:
+- a hashMap.HashMap from key to list node finds the node of a key in O(1)
+- a doublyLinkedListHM.LinkedList keeps the nodes in recency order and unlinks a node in O(1) via its Prev and Next pointers.
+
+The head of the list is the most recently used pair. The list itself has no tail pointer (see doublyLinkedListHM),
+so the cache list remembers the tail, which is the least recently used pair and is evicted first.
+*/
+
+type LRU[K, V any] struct {
+	capacity uint
+	index    *hashMap.HashMap[K, *doublyLinkedListHM.Node[K, V]]
+	// list holds the pairs from the most (Head) to the least (tail) recently used.
+	list    *list[K, V]
+	onEvict func(key K, val V)
+}
+
+// NewLRU creates a new LRU cache which holds up to capacity pairs. Runtime O(capacity)
+//
+// A capacity of 0 is treated as 1.
+//
+// opts - Optional configuration of the index HashMap, e.g. hashMap.WithHasher.
+func NewLRU[K, V any](capacity uint, opts ...hashMap.Option[K]) *LRU[K, V] {
+	return NewLRUWithEvict[K, V](capacity, nil, opts...)
+}
+
+// NewLRUWithEvict creates a new LRU cache, which calls onEvict for every pair it evicts. Runtime O(capacity)
+//
+// onEvict is only called for pairs which are pushed out by Put or Resize, not for Remove or Clear.
+func NewLRUWithEvict[K, V any](capacity uint, onEvict func(key K, val V), opts ...hashMap.Option[K]) *LRU[K, V] {
+	capacity = max(capacity, 1)
+	return &LRU[K, V]{
+		capacity: capacity,
+		index:    hashMap.NewHashMap[K, *doublyLinkedListHM.Node[K, V]](capacity, opts...),
+		list:     newList[K, V](),
+		onEvict:  onEvict,
+	}
+}
+
+// Get value by key and mark it as the most recently used. Runtime O(1)
+//
+// Returns the value and whether it was found.
+func (c *LRU[K, V]) Get(key K) (val V, found bool, err error) {
+	node, err := c.index.Get(key)
+	if err != nil || node == nil {
+		return val, false, err
+	}
+
+	c.list.moveToFront(*node)
+	return (*node).Value, true, nil
+}
+
+// Peek returns the value of key without marking it as used. Runtime O(1)
+func (c *LRU[K, V]) Peek(key K) (val V, found bool, err error) {
+	node, err := c.index.Get(key)
+	if err != nil || node == nil {
+		return val, false, err
+	}
+	return (*node).Value, true, nil
+}
+
+// Put inserts a key value pair or replaces the value of key, and marks it as the most recently used. Runtime O(1)
+//
+// If the cache is full, the least recently used pair is evicted.
+//
+// Returns whether a pair was evicted.
+func (c *LRU[K, V]) Put(key K, val V) (evicted bool, err error) {
+	// Looking the key up first reuses the node of an existing key instead of allocating a new one.
+	node, err := c.index.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	if node != nil {
+		(*node).Value = val
+		c.list.moveToFront(*node)
+		return false, nil
+	}
+
+	newNode := &doublyLinkedListHM.Node[K, V]{Key: key, Value: val}
+	if err := c.index.Insert(key, newNode); err != nil {
+		return false, err
+	}
+	c.list.pushFront(newNode)
+	if c.list.Size > c.capacity {
+		return true, c.evict()
+	}
+	return false, nil
+}
+
+// Remove key value pair by key without calling the eviction callback. Runtime O(1)
+//
+// Returns the value and whether it was found.
+func (c *LRU[K, V]) Remove(key K) (val V, found bool, err error) {
+	node, err := c.index.Remove(key)
+	if err != nil || node == nil {
+		return val, false, err
+	}
+
+	c.list.remove(*node)
+	return (*node).Value, true, nil
+}
+
+// Contains checks if key is cached without marking it as used. Runtime O(1)
+func (c *LRU[K, V]) Contains(key K) (bool, error) {
+	return c.index.ContainsKey(key)
+}
+
+// Len returns the number of cached pairs. Runtime O(1)
+func (c *LRU[K, V]) Len() uint {
+	return c.list.Size
+}
+
+// Capacity returns the maximum number of cached pairs. Runtime O(1)
+func (c *LRU[K, V]) Capacity() uint {
+	return c.capacity
+}
+
+// Keys returns all keys from the most to the least recently used. Runtime O(n)
+func (c *LRU[K, V]) Keys() []K {
+	return c.list.keys()
+}
+
+// Resize changes the capacity and evicts the least recently used pairs which do not fit anymore. Runtime O(evicted)
+//
+// A capacity of 0 is treated as 1.
+//
+// Returns the number of evicted pairs.
+func (c *LRU[K, V]) Resize(capacity uint) (evicted uint, err error) {
+	c.capacity = max(capacity, 1)
+	for c.list.Size > c.capacity {
+		if err := c.evict(); err != nil {
+			return evicted, err
+		}
+		evicted++
+	}
+	return evicted, nil
+}
+
+// Clear the cache without calling the eviction callback. Runtime O(1)
+func (c *LRU[K, V]) Clear() {
+	c.index.Clear()
+	c.list = newList[K, V]()
+}
+
+// evict removes the least recently used pair and calls the eviction callback.
+func (c *LRU[K, V]) evict() error {
+	node := c.list.back()
+	if _, err := c.index.Remove(node.Key); err != nil {
+		return err
+	}
+	c.list.remove(node)
+
+	if c.onEvict != nil {
+		c.onEvict(node.Key, node.Value)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"dsa/datastructures/hashMap"
+	"dsa/util/sugar"
+	"reflect"
+	"testing"
+)
+
+type opKind int
+
+const (
+	opPut opKind = iota
+	opGet
+	opPeek
+	opRemove
+)
+
+type op struct {
+	kind opKind
+	key  int
+	val  int
+}
+
+func put(key, val int) op { return op{opPut, key, val} }
+func get(key int) op      { return op{kind: opGet, key: key} }
+func peek(key int) op     { return op{kind: opPeek, key: key} }
+func remove(key int) op   { return op{kind: opRemove, key: key} }
+
+// apply runs ops on c and ignores the results.
+func apply(c Cache[int, int], ops []op) {
+	for _, o := range ops {
+		switch o.kind {
+		case opPut:
+			_, _ = c.Put(o.key, o.val)
+		case opGet:
+			_, _, _ = c.Get(o.key)
+		case opPeek:
+			_, _, _ = c.Peek(o.key)
+		case opRemove:
+			_, _, _ = c.Remove(o.key)
+		}
+	}
+}
+
+func TestLRU_EvictionOrder(t *testing.T) {
+	type testCase struct {
+		name        string
+		capacity    uint
+		ops         []op
+		wantEvicted []int
+		wantKeys    []int
+	}
+	tests := []testCase{
+		{"no eviction below capacity", 3, []op{put(1, 1), put(2, 2)}, []int{}, []int{2, 1}},
+		{"evict oldest put", 2, []op{put(1, 1), put(2, 2), put(3, 3)}, []int{1}, []int{3, 2}},
+		{"get promotes", 2, []op{put(1, 1), put(2, 2), get(1), put(3, 3)}, []int{2}, []int{3, 1}},
+		{"peek does not promote", 2, []op{put(1, 1), put(2, 2), peek(1), put(3, 3)}, []int{1}, []int{3, 2}},
+		{"put of existing key promotes", 2, []op{put(1, 1), put(2, 2), put(1, 10), put(3, 3)}, []int{2}, []int{3, 1}},
+		{"get of missing key changes nothing", 2, []op{put(1, 1), put(2, 2), get(9), put(3, 3)}, []int{1}, []int{3, 2}},
+		{"remove makes room", 2, []op{put(1, 1), put(2, 2), remove(1), put(3, 3)}, []int{}, []int{3, 2}},
+		{"remove tail then evict", 3, []op{put(1, 1), put(2, 2), put(3, 3), remove(1), put(4, 4), put(5, 5)}, []int{2}, []int{5, 4, 3}},
+		{"capacity 0 holds one pair", 0, []op{put(1, 1), put(2, 2)}, []int{1}, []int{2}},
+		{
+			"mixed workload",
+			3,
+			[]op{put(1, 1), put(2, 2), put(3, 3), get(1), put(4, 4), get(3), put(5, 5), peek(1), put(6, 6), get(5), put(7, 7)},
+			[]int{2, 1, 4, 3},
+			[]int{7, 5, 6},
+		},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			evicted := make([]int, 0)
+			c := NewLRUWithEvict[int, int](tt.capacity, func(key, val int) {
+				if key != val {
+					t.Errorf("onEvict() got key %v with value %v", key, val)
+				}
+				evicted = append(evicted, key)
+			})
+			apply(c, tt.ops)
+			if !reflect.DeepEqual(evicted, tt.wantEvicted) {
+				t.Errorf("evicted = %v, want %v", evicted, tt.wantEvicted)
+			}
+			if got := c.Keys(); !reflect.DeepEqual(got, tt.wantKeys) {
+				t.Errorf("Keys() = %v, want %v", got, tt.wantKeys)
+			}
+			if c.Len() != uint(len(tt.wantKeys)) {
+				t.Errorf("Len() = %v, want %v", c.Len(), len(tt.wantKeys))
+			}
+		})
+	}
+}
+
+func TestLRU_Values(t *testing.T) {
+	name := "get, peek, put and remove return values"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		c := NewLRU[string, int](2)
+		if evicted, err := c.Put("a", 1); evicted || err != nil {
+			t.Errorf("Put() = %v, %v, want false, nil", evicted, err)
+		}
+		_, _ = c.Put("a", 2)
+		if got, found, _ := c.Get("a"); !found || got != 2 {
+			t.Errorf("Get() = %v, %v, want 2, true", got, found)
+		}
+		if got, found, _ := c.Peek("b"); found || got != 0 {
+			t.Errorf("Peek() = %v, %v, want 0, false", got, found)
+		}
+		_, _ = c.Put("b", 3)
+		if evicted, _ := c.Put("c", 4); !evicted {
+			t.Errorf("Put() did not evict")
+		}
+		if found, _ := c.Contains("a"); found {
+			t.Errorf("Contains() found evicted key")
+		}
+		if got, found, _ := c.Remove("b"); !found || got != 3 {
+			t.Errorf("Remove() = %v, %v, want 3, true", got, found)
+		}
+		c.Clear()
+		if c.Len() != 0 || len(c.Keys()) != 0 {
+			t.Errorf("Clear() did not empty the cache")
+		}
+	})
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		c := NewLRU[func(), int](2)
+		if _, err := c.Put(func() {}, 1); err == nil {
+			t.Errorf("Put() expected error for unhashable key")
+		}
+		if c.Len() != 0 {
+			t.Errorf("Len() = %v, want 0", c.Len())
+		}
+	})
+}
+
+func TestLRU_PutExistingKey(t *testing.T) {
+	name := "put of an existing key reuses its node"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		c := NewLRU[int, int](3, hashMap.WithHasher[int](hashMap.ComparableHasher[int]{}))
+		apply(c, []op{put(1, 1), put(2, 2), put(3, 3)})
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = c.Put(1, 10)
+		})
+		if allocs != 0 {
+			t.Errorf("Put() of an existing key allocated %v times, want 0", allocs)
+		}
+		if got := c.Keys(); !reflect.DeepEqual(got, []int{1, 3, 2}) {
+			t.Errorf("Keys() = %v, want %v", got, []int{1, 3, 2})
+		}
+		if got, found, _ := c.Peek(1); !found || got != 10 {
+			t.Errorf("Peek() = %v, %v, want 10, true", got, found)
+		}
+	})
+}
+
+func TestLRU_Resize(t *testing.T) {
+	type testCase struct {
+		name        string
+		capacity    uint
+		wantEvicted uint
+		wantKeys    []int
+	}
+	tests := []testCase{
+		{"grow", 10, 0, []int{5, 4, 3, 2, 1}},
+		{"same", 5, 0, []int{5, 4, 3, 2, 1}},
+		{"shrink", 2, 3, []int{5, 4}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			c := NewLRU[int, int](5)
+			for k := 1; k <= 5; k++ {
+				_, _ = c.Put(k, k)
+			}
+			if evicted, err := c.Resize(tt.capacity); evicted != tt.wantEvicted || err != nil {
+				t.Errorf("Resize() = %v, %v, want %v, nil", evicted, err, tt.wantEvicted)
+			}
+			if got := c.Keys(); !reflect.DeepEqual(got, tt.wantKeys) {
+				t.Errorf("Keys() = %v, want %v", got, tt.wantKeys)
+			}
+			if c.Capacity() != tt.capacity {
+				t.Errorf("Capacity() = %v, want %v", c.Capacity(), tt.capacity)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package cache
+
+// TraceResult counts the hits and misses of replaying a trace.
+type TraceResult struct {
+	Requests uint
+	Hits     uint
+}
+
+// HitRatio returns the share of requests which were hits, or 0 for an empty trace.
+func (r TraceResult) HitRatio() float64 {
+	if r.Requests == 0 {
+		return 0
+	}
+	return float64(r.Hits) / float64(r.Requests)
+}
+
+// Replay requests every key of trace from c, the way an application uses a cache in front of a slow store:
+// a hit is served by Get, on a miss the value is loaded with load and Put into c. Runtime O(len(trace))
+//
+// Returns the number of requests and hits.
+func Replay[K, V any](c Cache[K, V], trace []K, load func(key K) V) (TraceResult, error) {
+	var r TraceResult
+	for _, key := range trace {
+		r.Requests++
+		_, found, err := c.Get(key)
+		if err != nil {
+			return r, err
+		}
+		if found {
+			r.Hits++
+			continue
+		}
+		if _, err := c.Put(key, load(key)); err != nil {
+			return r, err
+		}
+	}
+	return r, nil
+}
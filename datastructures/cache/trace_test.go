@@ -0,0 +1,142 @@
+package cache
+
+import (
+	"dsa/util/sugar"
+	"math/rand"
+	"testing"
+)
+
+/*
+Trace driven comparison of the cache policies.
+
+Every trace is replayed against a fresh cache of every policy and the hit ratios are logged (go test -v).
+The assertions only check the well known strengths and weaknesses of the policies, not exact numbers.
+*/
+
+var policies = []struct {
+	name string
+	new  func(capacity uint) Cache[int, int]
+}{
+	{"LRU", func(capacity uint) Cache[int, int] { return NewLRU[int, int](capacity) }},
+	{"LFU", func(capacity uint) Cache[int, int] { return NewLFU[int, int](capacity) }},
+	{"ARC", func(capacity uint) Cache[int, int] { return NewARC[int, int](capacity) }},
+}
+
+func identity(key int) int { return key }
+
+// loopTrace requests the keys 0 to n-1 over and over again.
+func loopTrace(n, rounds int) []int {
+	trace := make([]int, 0, n*rounds)
+	for range rounds {
+		for k := range n {
+			trace = append(trace, k)
+		}
+	}
+	return trace
+}
+
+// hotScanTrace mixes requests of a small hot set with long scans over keys which are never requested again.
+func hotScanTrace(hot, scan, rounds int) []int {
+	trace := make([]int, 0)
+	next := hot
+	for range rounds {
+		for range 3 {
+			for k := range hot {
+				trace = append(trace, k)
+			}
+		}
+		for range scan {
+			trace = append(trace, next)
+			next++
+		}
+	}
+	return trace
+}
+
+// zipfTrace requests n keys out of keys with a Zipf distribution, so a few keys are requested very often.
+func zipfTrace(keys uint64, n int) []int {
+	zipf := rand.NewZipf(rand.New(rand.NewSource(7757)), 1.1, 1, keys-1)
+	trace := make([]int, n)
+	for i := range trace {
+		trace[i] = int(zipf.Uint64())
+	}
+	return trace
+}
+
+func TestReplay(t *testing.T) {
+	type testCase struct {
+		name     string
+		capacity uint
+		trace    []int
+		want     TraceResult
+	}
+	tests := []testCase{
+		{"empty trace", 2, []int{}, TraceResult{0, 0}},
+		{"all misses", 2, []int{1, 2, 3, 4}, TraceResult{4, 0}},
+		{"repeated key", 2, []int{1, 1, 1}, TraceResult{3, 2}},
+		{"mixed", 2, []int{1, 2, 1, 3, 1, 2}, TraceResult{6, 2}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			got, err := Replay[int, int](NewLRU[int, int](tt.capacity), tt.trace, identity)
+			if got != tt.want || err != nil {
+				t.Errorf("Replay() = %v, %v, want %v, nil", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplay_HitRatios(t *testing.T) {
+	type testCase struct {
+		name     string
+		capacity uint
+		trace    []int
+		// check gets the hit ratio of every policy by name.
+		check func(t *testing.T, ratios map[string]float64)
+	}
+	tests := []testCase{
+		{"loop larger than the cache", 100, loopTrace(101, 50), func(t *testing.T, ratios map[string]float64) {
+			// Every key is evicted right before it is requested again. Without a single hit,
+			// LFU and ARC never learn which keys to keep and are not better than LRU.
+			for name, ratio := range ratios {
+				if ratio != 0 {
+					t.Errorf("%v hit ratio = %v, want 0", name, ratio)
+				}
+			}
+		}},
+		{"hot set with scans", 50, hotScanTrace(30, 100, 20), func(t *testing.T, ratios map[string]float64) {
+			if ratios["LFU"] <= ratios["LRU"] || ratios["ARC"] <= ratios["LRU"] {
+				t.Errorf("LFU and ARC should keep the hot set during scans and beat LRU, got %v", ratios)
+			}
+		}},
+		{"zipf", 100, zipfTrace(10000, 50000), func(t *testing.T, ratios map[string]float64) {
+			for name, ratio := range ratios {
+				if ratio <= 0.3 {
+					t.Errorf("%v hit ratio = %v, want > 0.3 for a skewed workload", name, ratio)
+				}
+			}
+		}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			ratios := make(map[string]float64)
+			for _, p := range policies {
+				c := p.new(tt.capacity)
+				r, err := Replay[int, int](c, tt.trace, identity)
+				if err != nil {
+					t.Fatalf("Replay() %v error = %v", p.name, err)
+				}
+				if c.Len() > tt.capacity {
+					t.Errorf("%v Len() = %v exceeds capacity %v", p.name, c.Len(), tt.capacity)
+				}
+				ratios[p.name] = r.HitRatio()
+				t.Logf("%-4s hit ratio %6.2f%% (%d/%d)", p.name, r.HitRatio()*100, r.Hits, r.Requests)
+			}
+			tt.check(t, ratios)
+		})
+	}
+}
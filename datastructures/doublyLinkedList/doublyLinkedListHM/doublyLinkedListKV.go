@@ -86,15 +86,23 @@ func (l *LinkedList[K, V]) GetNodeByValue(value V) (node *Node[K, V], found bool
 // To avoid needing a tail and without losing performance, the key/value pair is always pushed as a new head,
 // because ordering does not matter here.
 func (l *LinkedList[K, V]) Push(key K, value V) *Node[K, V] {
-	newNode := &Node[K, V]{key, value, l.Head, nil}
+	return l.PushNode(&Node[K, V]{Key: key, Value: value})
+}
+
+// PushNode inserts an existing node as the new head of the list, e.g. to move it from another list without allocating.
+//
+// Returns the pushed Node.
+func (l *LinkedList[K, V]) PushNode(node *Node[K, V]) *Node[K, V] {
+	node.Next = l.Head
+	node.Prev = nil
 
 	l.Size++
 	if l.Head != nil {
-		l.Head.Prev = newNode
+		l.Head.Prev = node
 	}
-	l.Head = newNode
+	l.Head = node
 
-	return newNode
+	return node
 }
 
 // Remove the Node with key.
@@ -112,9 +120,20 @@ func (l *LinkedList[K, V]) Remove(key K) (v V, found bool) {
 		return v, false
 	}
 
+	l.RemoveNode(delNode)
+	v = delNode.Value
+	delNode = nil
+
+	return v, true
+}
+
+// RemoveNode unlinks node from the list in O(1) using its Prev and Next pointers.
+//
+// The node must be part of the list.
+func (l *LinkedList[K, V]) RemoveNode(node *Node[K, V]) {
 	l.Size--
-	prevNode := delNode.Prev
-	nextNode := delNode.Next
+	prevNode := node.Prev
+	nextNode := node.Next
 	if prevNode != nil {
 		prevNode.Next = nextNode
 		if nextNode != nil {
@@ -126,8 +145,4 @@ func (l *LinkedList[K, V]) Remove(key K) (v V, found bool) {
 			l.Head.Prev = nil
 		}
 	}
-	v = delNode.Value
-	delNode = nil
-
-	return v, true
 }
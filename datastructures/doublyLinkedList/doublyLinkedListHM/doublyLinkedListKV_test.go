@@ -285,6 +285,45 @@ func TestLinkedList_Push(t *testing.T) {
 	}
 }
 
+func TestLinkedList_PushNode(t *testing.T) {
+	type testCase[K, V any] struct {
+		name               string
+		existingLinkedList *LinkedList[K, V]
+		node               *Node[K, V]
+		wantLL             *LinkedList[K, V]
+	}
+
+	tests := []testCase[int, int]{
+		{
+			"push node to empty ll",
+			ll[int, int]([]int{}, []int{}),
+			&Node[int, int]{Key: 1, Value: 2},
+			ll[int, int]([]int{1}, []int{2}),
+		},
+		{
+			"push node of another ll to existing ll",
+			ll[int, int]([]int{1}, []int{1}),
+			// The pointers to the old list must be reset.
+			ll[int, int]([]int{4, 2, 5}, []int{4, 3, 5}).Head.Next,
+			ll[int, int]([]int{1, 2}, []int{1, 3}),
+		},
+	}
+
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			gotNode := tt.existingLinkedList.PushNode(tt.node)
+			if !reflect.DeepEqual(tt.existingLinkedList, tt.wantLL) {
+				t.Errorf("PushNode() = %v, wantLL %v", tt.existingLinkedList, tt.wantLL)
+			}
+			if gotNode != tt.node {
+				t.Errorf("PushNode() gotNode = %v, wantNode %v", gotNode, tt.node)
+			}
+		})
+	}
+}
+
 func TestNewLinkedList(t *testing.T) {
 	type args[K, V any] struct {
 		key K
@@ -393,3 +432,48 @@ func TestLinkedList_Remove(t *testing.T) {
 		})
 	}
 }
+
+func TestLinkedList_RemoveNode(t *testing.T) {
+	type testCase[K, V any] struct {
+		name               string
+		existingLinkedList *LinkedList[K, V]
+		node               func(l *LinkedList[K, V]) *Node[K, V]
+		wantLL             *LinkedList[K, V]
+	}
+	tests := []testCase[int, int]{
+		{
+			"remove only node of list",
+			ll[int, int]([]int{1}, []int{2}),
+			func(l *LinkedList[int, int]) *Node[int, int] { return l.Head },
+			ll[int, int]([]int{}, []int{}),
+		},
+		{
+			"remove head of list",
+			ll[int, int]([]int{1, 2, 3}, []int{4, 5, 6}),
+			func(l *LinkedList[int, int]) *Node[int, int] { return l.Head },
+			ll[int, int]([]int{1, 2}, []int{4, 5}),
+		},
+		{
+			"remove tail of list",
+			ll[int, int]([]int{1, 2, 3}, []int{4, 5, 6}),
+			func(l *LinkedList[int, int]) *Node[int, int] { return l.Head.Next.Next },
+			ll[int, int]([]int{2, 3}, []int{5, 6}),
+		},
+		{
+			"remove middle of list",
+			ll[int, int]([]int{1, 2, 3}, []int{4, 5, 6}),
+			func(l *LinkedList[int, int]) *Node[int, int] { return l.Head.Next },
+			ll[int, int]([]int{1, 3}, []int{4, 6}),
+		},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			tt.existingLinkedList.RemoveNode(tt.node(tt.existingLinkedList))
+			if !reflect.DeepEqual(tt.existingLinkedList, tt.wantLL) {
+				t.Errorf("actual LL = %v, wantLL %v", tt.existingLinkedList, tt.wantLL)
+			}
+		})
+	}
+}
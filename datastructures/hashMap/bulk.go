@@ -0,0 +1,197 @@
+package hashMap
+
+import (
+	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+	"reflect"
+)
+
+/*
+Bulk operations work on whole buckets instead of calling Insert or Remove for every pair.
+
+Clone, Filter and MapValues keep the seed and the Hasher, so every pair stays in the bucket it already is in.
+They copy the buckets one by one without hashing a single key. Only Filter and DeleteIf may shrink the result,
+and then only once at the end instead of after every removed pair.
+Merge reserves room for the larger of both maps up front. Overlapping keys do not need more buckets,
+so only the pairs which are really new let the HashMap grow as usual.
+*/
+
+// Clone returns a copy of the HashMap with the same seed, Hasher and LoadOptions. Runtime O(n)
+//
+// The pairs are copied bucket by bucket without rehashing them. Keys and values are copied shallowly.
+func (hm *HashMap[K, V]) Clone() *HashMap[K, V] {
+	return mapBuckets(hm, func(_ K, v V) (V, bool) { return v, true })
+}
+
+// Filter returns a new HashMap with only the pairs for which pred returns true. Runtime O(n)
+//
+// The new HashMap has the same seed, Hasher and LoadOptions. It shrinks at most once, after all pairs are copied.
+func (hm *HashMap[K, V]) Filter(pred func(key K, val V) bool) *HashMap[K, V] {
+	filtered := mapBuckets(hm, func(k K, v V) (V, bool) { return v, pred(k, v) })
+	filtered.shrinkOnce()
+	return filtered
+}
+
+// MapValues returns a new HashMap with the same keys and the values transformed by fn. Runtime O(n)
+//
+// The new HashMap has the same seed, Hasher and LoadOptions, so the keys are not rehashed.
+// This is a function instead of a method, because methods can not have type parameters.
+func MapValues[K, V, W any](hm *HashMap[K, V], fn func(key K, val V) W) *HashMap[K, W] {
+	return mapBuckets(hm, func(k K, v V) (W, bool) { return fn(k, v), true })
+}
+
+// DeleteIf removes every pair for which pred returns true. Runtime O(n)
+//
+// The HashMap shrinks at most once, after all pairs are removed.
+//
+// Returns the number of removed pairs.
+func (hm *HashMap[K, V]) DeleteIf(pred func(key K, val V) bool) (removed uint) {
+	// While rehashing, the pairs are spread over the new and the old buckets.
+	removed = deleteFromBuckets(hm.Pairs, pred) + deleteFromBuckets(hm.oldPairs, pred)
+
+	hm.Size -= removed
+	hm.shrinkOnce()
+	return removed
+}
+
+// Merge inserts all pairs of other into the HashMap. Runtime O(n + m)
+//
+// Room for the larger of both maps is reserved first. If other has keys the HashMap does not have yet,
+// the HashMap grows as usual while merging.
+//
+// conflict - Returns the value for a key which exists in both maps. If nil, the value of other wins.
+//
+// Returns the error if a key of other could not be hashed with the Hasher of the HashMap.
+// The pairs merged before stay in the HashMap.
+func (hm *HashMap[K, V]) Merge(other *HashMap[K, V], conflict func(key K, old, new V) V) error {
+	hm.Reserve(max(hm.Size, other.Size))
+
+	for k, v := range other.All() {
+		h, _, node, err := hm.lookup(k)
+		if err != nil {
+			return err
+		}
+
+		if node == nil {
+			if _, err := hm.insertNew(k, v, h); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if conflict == nil {
+			node.Value = v
+		} else {
+			node.Value = conflict(k, node.Value, v)
+		}
+	}
+	return nil
+}
+
+// Equal - Check if both maps contain the same keys with equal values. Runtime O(n)
+//
+// Keys are compared with the Hasher of other, so both maps should use equivalent Hashers.
+//
+// valEq - Compares two values. If nil, values are compared with reflect.DeepEqual.
+func (hm *HashMap[K, V]) Equal(other *HashMap[K, V], valEq func(a, b V) bool) (bool, error) {
+	if hm.Size != other.Size {
+		return false, nil
+	}
+	if valEq == nil {
+		valEq = func(a, b V) bool { return reflect.DeepEqual(a, b) }
+	}
+
+	for k, v := range hm.All() {
+		otherVal, err := other.Get(k)
+		if err != nil || otherVal == nil || !valEq(v, *otherVal) {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// deleteFromBuckets removes every pair for which pred returns true from buckets and sets emptied buckets to nil.
+//
+// Returns the number of removed pairs.
+func deleteFromBuckets[K, V any](buckets []*doublyLinkedListHM.LinkedList[K, V], pred func(key K, val V) bool) (removed uint) {
+	for i, bucket := range buckets {
+		if bucket == nil {
+			continue
+		}
+		for node := bucket.Head; node != nil; {
+			next := node.Next
+			if pred(node.Key, node.Value) {
+				bucket.RemoveNode(node)
+				removed++
+			}
+			node = next
+		}
+		if bucket.IsEmpty() {
+			buckets[i] = nil
+		}
+	}
+	return removed
+}
+
+// mapBuckets copies the HashMap bucket by bucket into a new HashMap with the same seed, Hasher and LoadOptions.
+// The buckets keep their length and the order of their nodes, also the ones which are still being rehashed.
+//
+// fn returns the new value of every pair and whether to keep it.
+func mapBuckets[K, V, W any](hm *HashMap[K, V], fn func(key K, val V) (W, bool)) *HashMap[K, W] {
+	mapped := &HashMap[K, W]{
+		hasher:          hm.hasher,
+		seed:            hm.seed,
+		load:            hm.load,
+		initialCapacity: hm.initialCapacity,
+		rehashIndex:     hm.rehashIndex,
+	}
+	mapped.Pairs = copyBuckets(hm.Pairs, fn, &mapped.Size)
+	if hm.oldPairs != nil {
+		mapped.oldPairs = copyBuckets(hm.oldPairs, fn, &mapped.Size)
+	}
+	return mapped
+}
+
+// copyBuckets copies every bucket node by node, keeping the order of the nodes.
+//
+// Adds the number of kept pairs to size.
+func copyBuckets[K, V, W any](buckets []*doublyLinkedListHM.LinkedList[K, V], fn func(key K, val V) (W, bool), size *uint) []*doublyLinkedListHM.LinkedList[K, W] {
+	copied := make([]*doublyLinkedListHM.LinkedList[K, W], len(buckets))
+	for i, bucket := range buckets {
+		if bucket == nil {
+			continue
+		}
+
+		var tail *doublyLinkedListHM.Node[K, W]
+		ll := &doublyLinkedListHM.LinkedList[K, W]{}
+		for node := bucket.Head; node != nil; node = node.Next {
+			val, keep := fn(node.Key, node.Value)
+			if !keep {
+				continue
+			}
+
+			// Appending at the tail keeps the order, Push would reverse it.
+			n := &doublyLinkedListHM.Node[K, W]{Key: node.Key, Value: val, Prev: tail}
+			if tail == nil {
+				ll.Head = n
+			} else {
+				tail.Next = n
+			}
+			tail = n
+			ll.Size++
+		}
+
+		if !ll.IsEmpty() {
+			copied[i] = ll
+			*size += ll.Size
+		}
+	}
+	return copied
+}
+
+// shrinkOnce shrinks the HashMap right away if Size dropped to the MinLoadFactor after removing many pairs at once.
+func (hm *HashMap[K, V]) shrinkOnce() {
+	if hm.shouldShrink() {
+		resizeHM(hm, hm.targetBuckets())
+		hm.finishRehash()
+	}
+}
@@ -0,0 +1,245 @@
+package hashMap
+
+import (
+	"dsa/util/sugar"
+	"maps"
+	"strconv"
+	"testing"
+)
+
+// rangeHM creates a HashMap with testSeed and the pairs i: i*10 for i in [0, n).
+func rangeHM(n int) *HashMap[int, int] {
+	hm := NewHashMapWithSeed[int, int](0, testSeed)
+	for i := range n {
+		_ = hm.Insert(i, i*10)
+	}
+	return hm
+}
+
+// rangeMap returns the Go map equivalent of rangeHM(n).
+func rangeMap(n int) map[int]int {
+	m := make(map[int]int, n)
+	for i := range n {
+		m[i] = i * 10
+	}
+	return m
+}
+
+// rehashingHM creates a HashMap like rangeHM, which is in the middle of a resize.
+func rehashingHM(n int) *HashMap[int, int] {
+	hm := rangeHM(n)
+	hm.finishRehash()
+	resizeHM(hm, uint(len(hm.Pairs))*4)
+	hm.rehashStep()
+	return hm
+}
+
+// checkLookups makes sure every pair of want can be found by Get, so all pairs are in their right bucket.
+func checkLookups(t *testing.T, hm *HashMap[int, int], want map[int]int) {
+	t.Helper()
+	checkOracle(t, 0, hm, want)
+	for k, v := range want {
+		if got, err := hm.Get(k); err != nil || got == nil || *got != v {
+			t.Fatalf("Get(%v) = %v, %v, want %v", k, got, err, v)
+		}
+	}
+}
+
+func TestHashMap_Clone(t *testing.T) {
+	type testCase struct {
+		name string
+		hm   *HashMap[int, int]
+		want map[int]int
+	}
+	tests := []testCase{
+		{"empty map", rangeHM(0), rangeMap(0)},
+		{"filled map", rangeHM(100), rangeMap(100)},
+		{"map while rehashing", rehashingHM(100), rangeMap(100)},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			clone := tt.hm.Clone()
+			checkLookups(t, clone, tt.want)
+			if len(clone.Pairs) != len(tt.hm.Pairs) || len(clone.oldPairs) != len(tt.hm.oldPairs) || clone.Seed() != tt.hm.Seed() {
+				t.Errorf("Clone() changed the bucket layout or the seed")
+			}
+
+			// Changing the clone must not change the original.
+			_ = clone.Insert(-1, -1)
+			_ = clone.Insert(0, -1)
+			_, _ = clone.Remove(1)
+			checkLookups(t, tt.hm, tt.want)
+		})
+	}
+}
+
+func TestHashMap_FilterDeleteIf(t *testing.T) {
+	type testCase struct {
+		name string
+		hm   func() *HashMap[int, int]
+		pred func(k, v int) bool
+		want map[int]int
+	}
+	even := func(k, _ int) bool { return k%2 == 0 }
+	tests := []testCase{
+		{"empty map", func() *HashMap[int, int] { return rangeHM(0) }, even, rangeMap(0)},
+		{"keep none", func() *HashMap[int, int] { return rangeHM(100) }, func(int, int) bool { return false }, rangeMap(0)},
+		{"keep all", func() *HashMap[int, int] { return rangeHM(100) }, func(int, int) bool { return true }, rangeMap(100)},
+		{"keep even keys", func() *HashMap[int, int] { return rangeHM(100) }, even,
+			maps.Collect(func(yield func(int, int) bool) {
+				for k, v := range rangeMap(100) {
+					if k%2 == 0 && !yield(k, v) {
+						return
+					}
+				}
+			})},
+		{"keep few while rehashing", func() *HashMap[int, int] { return rehashingHM(1000) }, func(k, _ int) bool { return k < 10 }, map[int]int{
+			0: 0, 1: 10, 2: 20, 3: 30, 4: 40, 5: 50, 6: 60, 7: 70, 8: 80, 9: 90,
+		}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			hm := tt.hm()
+			before := maps.Collect(hm.All())
+
+			filtered := hm.Filter(tt.pred)
+			checkLookups(t, filtered, tt.want)
+			checkLookups(t, hm, before)
+			if filtered.resizes > 1 {
+				t.Errorf("Filter() resized %v times, want at most once", filtered.resizes)
+			}
+
+			removed := hm.DeleteIf(func(k, v int) bool { return !tt.pred(k, v) })
+			if removed != uint(len(before)-len(tt.want)) {
+				t.Errorf("DeleteIf() = %v, want %v", removed, len(before)-len(tt.want))
+			}
+			checkLookups(t, hm, tt.want)
+			if hm.shouldShrink() && hm.Size > 0 {
+				t.Errorf("DeleteIf() did not shrink the map: Size %v, buckets %v", hm.Size, len(hm.Pairs))
+			}
+		})
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	name := "values to strings"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		hm := rehashingHM(100)
+		mapped := MapValues(hm, func(k, v int) string { return strconv.Itoa(k + v) })
+
+		if mapped.Size != hm.Size || len(mapped.Pairs) != len(hm.Pairs) {
+			t.Errorf("MapValues() Size, buckets = %v, %v, want %v, %v", mapped.Size, len(mapped.Pairs), hm.Size, len(hm.Pairs))
+		}
+		for k := range 100 {
+			if got, err := mapped.Get(k); err != nil || got == nil || *got != strconv.Itoa(k*11) {
+				t.Errorf("Get(%v) = %v, %v, want %v", k, got, err, strconv.Itoa(k*11))
+			}
+		}
+	})
+}
+
+func TestHashMap_Merge(t *testing.T) {
+	type testCase struct {
+		name     string
+		hm       *HashMap[int, int]
+		other    *HashMap[int, int]
+		conflict func(k, old, new int) int
+		want     map[int]int
+	}
+	sum := func(_, old, new int) int { return old + new }
+	tests := []testCase{
+		{"both empty", rangeHM(0), rangeHM(0), nil, rangeMap(0)},
+		{"into empty map", rangeHM(0), rangeHM(3), nil, rangeMap(3)},
+		{"empty other", rangeHM(3), rangeHM(0), nil, rangeMap(3)},
+		{"other wins", filledHM([]int{1, 2}, []int{1, 2}), filledHM([]int{2, 3}, []int{20, 30}), nil, map[int]int{1: 1, 2: 20, 3: 30}},
+		{"conflict function", filledHM([]int{1, 2}, []int{1, 2}), filledHM([]int{2, 3}, []int{20, 30}), sum, map[int]int{1: 1, 2: 22, 3: 30}},
+		{"other while rehashing", rangeHM(0), rehashingHM(100), nil, rangeMap(100)},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			resizes := tt.hm.resizes
+			if err := tt.hm.Merge(tt.other, tt.conflict); err != nil {
+				t.Errorf("Merge() threw error: %v", err)
+			}
+			checkLookups(t, tt.hm, tt.want)
+			if tt.hm.resizes > resizes+1 {
+				t.Errorf("Merge() resized %v times, want at most once", tt.hm.resizes-resizes)
+			}
+		})
+	}
+	println()
+	t.Run("overlapping keys do not grow", func(t *testing.T) {
+		defer sugar.Lite(t, "overlapping keys do not grow")
+		hm := rangeHM(100)
+		hm.finishRehash()
+		buckets := len(hm.Pairs)
+		if err := hm.Merge(rangeHM(100), nil); err != nil {
+			t.Errorf("Merge() threw error: %v", err)
+		}
+		checkLookups(t, hm, rangeMap(100))
+		if len(hm.Pairs) != buckets {
+			t.Errorf("Merge() buckets = %v, want %v", len(hm.Pairs), buckets)
+		}
+	})
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		// other can hash func keys, the default Hasher of hm can not.
+		hm := NewHashMapWithSeed[func(), int](1, testSeed)
+		other := NewHashMapWithSeed[func(), int](1, testSeed, WithHasher[func()](zeroHasher[func()]{}))
+		_ = other.Insert(func() {}, 1)
+		if err := hm.Merge(other, nil); err == nil {
+			t.Errorf("Merge() expected error for unhashable key")
+		}
+	})
+}
+
+func TestHashMap_Equal(t *testing.T) {
+	type testCase struct {
+		name  string
+		hm    *HashMap[int, int]
+		other *HashMap[int, int]
+		valEq func(a, b int) bool
+		want  bool
+	}
+	sameParity := func(a, b int) bool { return a%2 == b%2 }
+	tests := []testCase{
+		{"both empty", rangeHM(0), rangeHM(0), nil, true},
+		{"equal maps", rangeHM(100), rehashingHM(100), nil, true},
+		{"different size", rangeHM(100), rangeHM(99), nil, false},
+		{"different key", filledHM([]int{1, 2}, []int{1, 2}), filledHM([]int{1, 3}, []int{1, 2}), nil, false},
+		{"different value", filledHM([]int{1, 2}, []int{1, 2}), filledHM([]int{1, 2}, []int{1, 4}), nil, false},
+		{"custom value equality", filledHM([]int{1, 2}, []int{1, 2}), filledHM([]int{1, 2}, []int{1, 4}), sameParity, true},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			if got, err := tt.hm.Equal(tt.other, tt.valEq); got != tt.want || err != nil {
+				t.Errorf("Equal() = %v, %v, want %v, nil", got, err, tt.want)
+			}
+			if got, _ := tt.other.Equal(tt.hm, tt.valEq); got != tt.want {
+				t.Errorf("Equal() is not symmetric: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// zeroHasher hashes every key to 0, also keys the default Hasher can not hash.
+type zeroHasher[K any] struct{}
+
+func (zeroHasher[K]) Hash(K, uint32) (uint32, error) {
+	return 0, nil
+}
+
+func (zeroHasher[K]) Equal(K, K) bool {
+	return false
+}
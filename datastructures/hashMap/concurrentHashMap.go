@@ -0,0 +1,240 @@
+package hashMap
+
+import (
+	"iter"
+	"sync"
+)
+
+/*
+Thread-safe variant of the HashMap with striped locking.
+
+Instead of one HashMap behind a single mutex, the key space is split into stripes. Every stripe is its own HashMap
+guarded by its own sync.RWMutex, so goroutines working on keys in different stripes never wait for each other,
+and readers of the same stripe can read in parallel.
+
+Resizing: Every stripe resizes its own buckets while holding only its own lock. The other stripes stay fully usable,
+so there is never a stop-the-world pause for the whole map.
+
+The stripe of a key is chosen with the seed of the ConcurrentHashMap. The stripes have their own random seeds,
+so the keys of one stripe are still spread over all of its buckets.
+*/
+
+type stripe[K, V any] struct {
+	mu sync.RWMutex
+	hm *HashMap[K, V]
+}
+
+type ConcurrentHashMap[K, V any] struct {
+	stripes []*stripe[K, V]
+	// hasher is nil for the default Murmur3Hasher.
+	hasher Hasher[K]
+	seed   uint32
+}
+
+// NewConcurrentHashMap creates a new thread-safe HashMap with a random seed. Runtime O(n)
+//
+// initialCapacity - The initial capacity of the whole map, spread over all stripes.
+//
+// stripes - The number of independently locked stripes. More stripes allow more goroutines to write in parallel.
+// A value of 0 creates a single stripe.
+//
+// opts - Optional configuration, e.g. WithHasher to replace the default Murmur3Hasher.
+func NewConcurrentHashMap[K, V any](initialCapacity uint, stripes uint, opts ...Option[K]) *ConcurrentHashMap[K, V] {
+	var o options[K]
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	stripes = max(stripes, 1)
+	chm := &ConcurrentHashMap[K, V]{
+		stripes: make([]*stripe[K, V], stripes),
+		hasher:  o.hasher,
+		seed:    randomSeed(),
+	}
+	for i := range chm.stripes {
+		chm.stripes[i] = &stripe[K, V]{hm: NewHashMap[K, V](initialCapacity/stripes, opts...)}
+	}
+	return chm
+}
+
+// Get value by key. Runtime O(1)
+//
+// Unlike HashMap.Get, a copy of the value is returned, because a pointer into the map could be changed by
+// another goroutine while it is read.
+//
+// Returns the value and whether it was found.
+func (chm *ConcurrentHashMap[K, V]) Get(key K) (val V, found bool, err error) {
+	s, err := chm.stripe(key)
+	if err != nil {
+		return val, false, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, err := s.hm.Get(key)
+	if err != nil || v == nil {
+		return val, false, err
+	}
+	return *v, true, nil
+}
+
+// Insert a key value pair or replace the value if key already exists. Runtime average case O(1), worst case O(n/stripes) when upsizing.
+func (chm *ConcurrentHashMap[K, V]) Insert(key K, val V) error {
+	s, err := chm.stripe(key)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hm.Insert(key, val)
+}
+
+// GetOrInsert returns the value of key, or atomically inserts val if key does not exist yet. Runtime average case O(1)
+//
+// Returns the value stored for key and whether it was already there (loaded) or val was inserted.
+func (chm *ConcurrentHashMap[K, V]) GetOrInsert(key K, val V) (actual V, loaded bool, err error) {
+	s, err := chm.stripe(key)
+	if err != nil {
+		return actual, false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, loaded, err := s.hm.GetOrInsert(key, val)
+	if err != nil {
+		return actual, false, err
+	}
+	return *v, loaded, nil
+}
+
+// Update atomically sets the value of key to the result of fn. Runtime average case O(1)
+//
+// fn is called while the stripe of key is locked, so it must not use the ConcurrentHashMap itself.
+func (chm *ConcurrentHashMap[K, V]) Update(key K, fn func(old V, ok bool) V) error {
+	s, err := chm.stripe(key)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hm.Update(key, fn)
+}
+
+// Remove key value pair by key. Runtime average case: O(1), worst case O(n/stripes) when downsizing.
+//
+// Returns the value and whether it was found.
+func (chm *ConcurrentHashMap[K, V]) Remove(key K) (val V, found bool, err error) {
+	s, err := chm.stripe(key)
+	if err != nil {
+		return val, false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, err := s.hm.Remove(key)
+	if err != nil || v == nil {
+		return val, false, err
+	}
+	return *v, true, nil
+}
+
+// ContainsKey - Check if key exists. Runtime O(1)
+func (chm *ConcurrentHashMap[K, V]) ContainsKey(key K) (bool, error) {
+	s, err := chm.stripe(key)
+	if err != nil {
+		return false, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.hm.ContainsKey(key)
+}
+
+// Size returns the number of key value pairs. Runtime O(stripes)
+//
+// While other goroutines are writing, the result is only a snapshot, because the stripes are counted one after another.
+func (chm *ConcurrentHashMap[K, V]) Size() uint {
+	var size uint
+	for _, s := range chm.stripes {
+		s.mu.RLock()
+		size += s.hm.Size
+		s.mu.RUnlock()
+	}
+	return size
+}
+
+// IsEmpty - Check if map is emtpy. Runtime O(stripes)
+func (chm *ConcurrentHashMap[K, V]) IsEmpty() bool {
+	return chm.Size() == 0
+}
+
+// All returns an iterator over all key value pairs. Runtime O(n)
+//
+// Every stripe is copied while it is read locked and then yielded without holding the lock,
+// so the loop body may use the ConcurrentHashMap. Changes of other goroutines may or may not be seen.
+func (chm *ConcurrentHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, s := range chm.stripes {
+			s.mu.RLock()
+			keys := make([]K, 0, s.hm.Size)
+			values := make([]V, 0, s.hm.Size)
+			for k, v := range s.hm.All() {
+				keys = append(keys, k)
+				values = append(values, v)
+			}
+			s.mu.RUnlock()
+
+			for i := range keys {
+				if !yield(keys[i], values[i]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Keys returns an array of all keys. Runtime O(n)
+func (chm *ConcurrentHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0)
+	for k := range chm.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns an array of all values. Runtime O(n)
+func (chm *ConcurrentHashMap[K, V]) Values() []V {
+	values := make([]V, 0)
+	for _, v := range chm.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Clear all stripes. Runtime O(stripes)
+func (chm *ConcurrentHashMap[K, V]) Clear() {
+	for _, s := range chm.stripes {
+		s.mu.Lock()
+		s.hm.Clear()
+		s.mu.Unlock()
+	}
+}
+
+// Hasher returns the Hasher used by the ConcurrentHashMap.
+func (chm *ConcurrentHashMap[K, V]) Hasher() Hasher[K] {
+	if chm.hasher == nil {
+		return Murmur3Hasher[K]{}
+	}
+	return chm.hasher
+}
+
+// stripe returns the stripe responsible for key.
+func (chm *ConcurrentHashMap[K, V]) stripe(key K) (*stripe[K, V], error) {
+	h, err := chm.Hasher().Hash(key, chm.seed)
+	if err != nil {
+		return nil, err
+	}
+	return chm.stripes[bucketIndex(h, len(chm.stripes))], nil
+}
@@ -0,0 +1,183 @@
+package hashMap
+
+import (
+	"dsa/util/sugar"
+	"fmt"
+	"maps"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+/*
+These tests hammer the ConcurrentHashMap from many goroutines at once.
+Run them with the race detector to find unsynchronized accesses: go test -race ./...
+*/
+
+const (
+	goroutines        = 16
+	keysPerGoroutine  = 500
+	concurrentStripes = 8
+)
+
+func TestConcurrentHashMap_Basic(t *testing.T) {
+	println()
+	t.Run("insert, get, remove", func(t *testing.T) {
+		defer sugar.Lite(t, "insert, get, remove")
+		chm := NewConcurrentHashMap[string, int](0, concurrentStripes)
+		chm.Insert("a", 1)
+		chm.Insert("b", 2)
+		chm.Insert("a", 3)
+		if got, found, _ := chm.Get("a"); !found || got != 3 {
+			t.Errorf("Get() got = %v, %v, want %v, true", got, found, 3)
+		}
+		if got, found, _ := chm.Remove("b"); !found || got != 2 {
+			t.Errorf("Remove() got = %v, %v, want %v, true", got, found, 2)
+		}
+		if _, found, _ := chm.Get("b"); found {
+			t.Errorf("Get() found removed key")
+		}
+		if found, _ := chm.ContainsKey("a"); !found {
+			t.Errorf("ContainsKey() did not find key")
+		}
+		if got, loaded, _ := chm.GetOrInsert("a", 5); !loaded || got != 3 {
+			t.Errorf("GetOrInsert() got = %v, %v, want %v, true", got, loaded, 3)
+		}
+		if chm.Size() != 1 {
+			t.Errorf("Size() = %v, want 1", chm.Size())
+		}
+		if got := maps.Collect(chm.All()); !reflect.DeepEqual(got, map[string]int{"a": 3}) {
+			t.Errorf("All() = %v, want %v", got, map[string]int{"a": 3})
+		}
+		chm.Clear()
+		if !chm.IsEmpty() || len(chm.Keys()) != 0 || len(chm.Values()) != 0 {
+			t.Errorf("Clear() did not empty the map")
+		}
+	})
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		chm := NewConcurrentHashMap[func(), int](0, concurrentStripes)
+		if err := chm.Insert(func() {}, 1); err == nil {
+			t.Errorf("Insert() should have thrown error")
+		}
+		if _, _, err := chm.Get(func() {}); err == nil {
+			t.Errorf("Get() should have thrown error")
+		}
+		if _, _, err := chm.Remove(func() {}); err == nil {
+			t.Errorf("Remove() should have thrown error")
+		}
+		if _, err := chm.ContainsKey(func() {}); err == nil {
+			t.Errorf("ContainsKey() should have thrown error")
+		}
+		if _, _, err := chm.GetOrInsert(func() {}, 1); err == nil {
+			t.Errorf("GetOrInsert() should have thrown error")
+		}
+		if err := chm.Update(func() {}, func(old int, ok bool) int { return 1 }); err == nil {
+			t.Errorf("Update() should have thrown error")
+		}
+	})
+}
+
+func TestConcurrentHashMap_Hammer(t *testing.T) {
+	println()
+	t.Run("parallel inserts, reads and removes", func(t *testing.T) {
+		defer sugar.Lite(t, "parallel inserts, reads and removes")
+		chm := NewConcurrentHashMap[string, int](0, concurrentStripes, WithHasher[string](ComparableHasher[string]{}))
+
+		var wg sync.WaitGroup
+		for g := range goroutines {
+			wg.Add(2)
+			// Writer: inserts its own keys, then removes every second one. The stripes resize many times meanwhile.
+			go func() {
+				defer wg.Done()
+				for i := range keysPerGoroutine {
+					if err := chm.Insert(fmt.Sprint(g, "-", i), i); err != nil {
+						t.Errorf("Insert() threw error: %v", err)
+					}
+				}
+				for i := 0; i < keysPerGoroutine; i += 2 {
+					chm.Remove(fmt.Sprint(g, "-", i))
+				}
+			}()
+			// Reader: reads keys of any writer while they are being inserted and removed.
+			go func() {
+				defer wg.Done()
+				for i := range keysPerGoroutine {
+					if v, found, _ := chm.Get(fmt.Sprint((g+1)%goroutines, "-", i)); found && v != i {
+						t.Errorf("Get() got = %v, want %v", v, i)
+					}
+					chm.ContainsKey(fmt.Sprint(g, "-", i))
+					if i%100 == 0 {
+						chm.Size()
+						for range chm.All() {
+						}
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		if want := uint(goroutines * keysPerGoroutine / 2); chm.Size() != want {
+			t.Errorf("Size() = %v, want %v", chm.Size(), want)
+		}
+		for g := range goroutines {
+			for i := range keysPerGoroutine {
+				_, found, _ := chm.Get(fmt.Sprint(g, "-", i))
+				if found != (i%2 == 1) {
+					t.Errorf("Get(%v-%v) found = %v, want %v", g, i, found, i%2 == 1)
+				}
+			}
+		}
+	})
+	println()
+	t.Run("parallel updates of the same keys", func(t *testing.T) {
+		defer sugar.Lite(t, "parallel updates of the same keys")
+		chm := NewConcurrentHashMap[int, int](0, concurrentStripes, WithHasher[int](ComparableHasher[int]{}))
+
+		var wg sync.WaitGroup
+		for range goroutines {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range keysPerGoroutine {
+					chm.Update(i%10, func(old int, ok bool) int {
+						return old + 1
+					})
+				}
+			}()
+		}
+		wg.Wait()
+
+		for k := range 10 {
+			if v, _, _ := chm.Get(k); v != goroutines*keysPerGoroutine/10 {
+				t.Errorf("Get(%v) = %v, want %v", k, v, goroutines*keysPerGoroutine/10)
+			}
+		}
+	})
+	println()
+	t.Run("parallel GetOrInsert inserts once", func(t *testing.T) {
+		defer sugar.Lite(t, "parallel GetOrInsert inserts once")
+		chm := NewConcurrentHashMap[int, int](0, concurrentStripes)
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		inserted := 0
+		for g := range goroutines {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, loaded, _ := chm.GetOrInsert(1, g); !loaded {
+					mu.Lock()
+					inserted++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		if inserted != 1 {
+			t.Errorf("GetOrInsert() inserted %v times, want 1", inserted)
+		}
+	})
+}
@@ -0,0 +1,214 @@
+package hashMap
+
+import (
+	"iter"
+	"time"
+)
+
+/*
+HashMap whose pairs can expire, e.g. for a session store.
+
+Every pair remembers when it expires. Expired pairs are removed lazily: Get, ContainsKey and Remove treat them as missing
+and remove them on the way, and All, Keys and Values skip them. Pairs which are never looked up again stay in memory
+until Sweep removes all expired pairs at once, so call Sweep regularly if many keys are never requested again.
+
+The time is read from a Clock, so tests can move the time forward with a fake clock instead of sleeping (see NewExpiringMapWithClock).
+*/
+
+// NoTTL is the TTL of pairs which never expire.
+const NoTTL time.Duration = -1
+
+// Clock tells the ExpiringMap the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+type expiringEntry[V any] struct {
+	value   V
+	expires time.Time
+	// noExpiry marks pairs which never expire. The zero time can not be used for this, because a Clock may return it.
+	noExpiry bool
+}
+
+type ExpiringMap[K, V any] struct {
+	hm    *HashMap[K, expiringEntry[V]]
+	clock Clock
+}
+
+// NewExpiringMap creates a new ExpiringMap with a random seed. Runtime O(n)
+//
+// initialCapacity - The initial capacity of the ExpiringMap on its creation.
+//
+// opts - Optional configuration, e.g. WithHasher to replace the default Murmur3Hasher.
+func NewExpiringMap[K, V any](initialCapacity uint, opts ...Option[K]) *ExpiringMap[K, V] {
+	return NewExpiringMapWithClock[K, V](initialCapacity, nil, opts...)
+}
+
+// NewExpiringMapWithClock creates a new ExpiringMap which reads the time from clock, e.g. a fake clock in tests. Runtime O(n)
+//
+// A nil clock means the system clock.
+func NewExpiringMapWithClock[K, V any](initialCapacity uint, clock Clock, opts ...Option[K]) *ExpiringMap[K, V] {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &ExpiringMap[K, V]{
+		hm:    NewHashMap[K, expiringEntry[V]](initialCapacity, opts...),
+		clock: clock,
+	}
+}
+
+// Get value by key. Runtime O(1)
+//
+// Returns nil if no value was found or the pair has expired. An expired pair is removed.
+func (em *ExpiringMap[K, V]) Get(key K) (val *V, err error) {
+	entry, err := em.live(key)
+	if err != nil || entry == nil {
+		return val, err
+	}
+	return &entry.value, nil
+}
+
+// Insert a key value pair which never expires, or replace the value and expiry if key already exists.
+// Runtime average case O(1), worst case O(n) when upsizing.
+func (em *ExpiringMap[K, V]) Insert(key K, val V) error {
+	return em.hm.Insert(key, expiringEntry[V]{value: val, noExpiry: true})
+}
+
+// InsertWithTTL inserts a key value pair which expires after ttl, or replaces the value and expiry if key already exists.
+// Runtime average case O(1), worst case O(n) when upsizing.
+//
+// A ttl <= 0 inserts a pair which has already expired.
+func (em *ExpiringMap[K, V]) InsertWithTTL(key K, val V, ttl time.Duration) error {
+	return em.hm.Insert(key, expiringEntry[V]{value: val, expires: em.clock.Now().Add(ttl)})
+}
+
+// TTL returns the time until key expires, or NoTTL if the pair never expires. Runtime O(1)
+//
+// Returns whether key was found. An expired pair is removed.
+func (em *ExpiringMap[K, V]) TTL(key K) (ttl time.Duration, found bool, err error) {
+	entry, err := em.live(key)
+	if err != nil || entry == nil {
+		return 0, false, err
+	}
+	if entry.noExpiry {
+		return NoTTL, true, nil
+	}
+	return entry.expires.Sub(em.clock.Now()), true, nil
+}
+
+// Remove key value pair by key. Runtime average case: O(1), worst case O(n) when downsizing.
+//
+// Returns the value or nil if no value was found or the pair has expired.
+func (em *ExpiringMap[K, V]) Remove(key K) (val *V, err error) {
+	entry, err := em.hm.Remove(key)
+	if err != nil || entry == nil || em.expired(*entry, em.clock.Now()) {
+		return val, err
+	}
+	return &entry.value, nil
+}
+
+// ContainsKey - Check if key exists and has not expired. Runtime O(1)
+//
+// An expired pair is removed.
+func (em *ExpiringMap[K, V]) ContainsKey(key K) (bool, error) {
+	entry, err := em.live(key)
+	return entry != nil, err
+}
+
+// Size returns the number of key value pairs, including expired pairs which were not removed yet. Runtime O(1)
+//
+// Call Sweep first to only count the pairs which have not expired.
+func (em *ExpiringMap[K, V]) Size() uint {
+	return em.hm.Size
+}
+
+// Sweep removes all expired pairs. Runtime O(n)
+//
+// Returns the number of removed pairs.
+func (em *ExpiringMap[K, V]) Sweep() (removed uint, err error) {
+	now := em.clock.Now()
+	expiredKeys := make([]K, 0)
+	for k, entry := range em.hm.All() {
+		if em.expired(entry, now) {
+			expiredKeys = append(expiredKeys, k)
+		}
+	}
+
+	for _, k := range expiredKeys {
+		if _, err := em.hm.Remove(k); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// All returns an iterator over all key value pairs which have not expired. Runtime O(n)
+//
+// The order is the bucket order. The ExpiringMap must not be modified while iterating.
+func (em *ExpiringMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		now := em.clock.Now()
+		for k, entry := range em.hm.All() {
+			if em.expired(entry, now) {
+				continue
+			}
+			if !yield(k, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an array of all keys which have not expired. Runtime O(n)
+func (em *ExpiringMap[K, V]) Keys() []K {
+	keys := make([]K, 0)
+	for k := range em.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns an array of all values which have not expired. Runtime O(n)
+func (em *ExpiringMap[K, V]) Values() []V {
+	values := make([]V, 0)
+	for _, v := range em.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Clear ExpiringMap, resetting it to a newly initialized state. Runtime O(1)
+func (em *ExpiringMap[K, V]) Clear() {
+	em.hm.Clear()
+}
+
+// Hasher returns the Hasher used by the ExpiringMap.
+func (em *ExpiringMap[K, V]) Hasher() Hasher[K] {
+	return em.hm.Hasher()
+}
+
+// live returns the entry of key, or nil if key does not exist or has expired. An expired pair is removed.
+func (em *ExpiringMap[K, V]) live(key K) (*expiringEntry[V], error) {
+	entry, err := em.hm.Get(key)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+
+	if em.expired(*entry, em.clock.Now()) {
+		_, err := em.hm.Remove(key)
+		return nil, err
+	}
+	return entry, nil
+}
+
+// expired reports whether entry has expired at now.
+func (em *ExpiringMap[K, V]) expired(entry expiringEntry[V], now time.Time) bool {
+	return !entry.noExpiry && !now.Before(entry.expires)
+}
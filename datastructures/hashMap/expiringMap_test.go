@@ -0,0 +1,255 @@
+package hashMap
+
+import (
+	"dsa/util/sugar"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock which only moves when the test advances it.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+// sessionMap returns an ExpiringMap with the keys "forever" (no expiry), "short" (1 minute) and "long" (1 hour).
+func sessionMap(clock *fakeClock) *ExpiringMap[string, int] {
+	em := NewExpiringMapWithClock[string, int](0, clock)
+	_ = em.Insert("forever", 1)
+	_ = em.InsertWithTTL("short", 2, time.Minute)
+	_ = em.InsertWithTTL("long", 3, time.Hour)
+	return em
+}
+
+func TestExpiringMap_Get(t *testing.T) {
+	type testCase struct {
+		name    string
+		elapsed time.Duration
+		key     string
+		want    *int
+	}
+	tests := []testCase{
+		{"before expiry", 59 * time.Second, "short", func() *int { v := 2; return &v }()},
+		{"exactly at expiry", time.Minute, "short", nil},
+		{"after expiry", 2 * time.Minute, "short", nil},
+		{"other key still alive", 2 * time.Minute, "long", func() *int { v := 3; return &v }()},
+		{"never expires", 1000 * time.Hour, "forever", func() *int { v := 1; return &v }()},
+		{"missing key", 0, "missing", nil},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			clock := newFakeClock()
+			em := sessionMap(clock)
+			clock.advance(tt.elapsed)
+
+			got, err := em.Get(tt.key)
+			if err != nil || (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("Get() = %v, %v, want %v", got, err, tt.want)
+			}
+			if found, _ := em.ContainsKey(tt.key); found != (tt.want != nil) {
+				t.Errorf("ContainsKey() = %v, want %v", found, tt.want != nil)
+			}
+		})
+	}
+}
+
+func TestExpiringMap_LazyExpiry(t *testing.T) {
+	type testCase struct {
+		name     string
+		lookup   func(em *ExpiringMap[string, int]) (found bool)
+		wantSize uint
+	}
+	tests := []testCase{
+		{"Get removes expired pair", func(em *ExpiringMap[string, int]) bool {
+			v, _ := em.Get("short")
+			return v != nil
+		}, 2},
+		{"ContainsKey removes expired pair", func(em *ExpiringMap[string, int]) bool {
+			found, _ := em.ContainsKey("short")
+			return found
+		}, 2},
+		{"Remove of expired pair returns nil", func(em *ExpiringMap[string, int]) bool {
+			v, _ := em.Remove("short")
+			return v != nil
+		}, 2},
+		{"Keys do not remove expired pair", func(em *ExpiringMap[string, int]) bool {
+			return slices.Contains(em.Keys(), "short")
+		}, 3},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			clock := newFakeClock()
+			em := sessionMap(clock)
+			clock.advance(time.Minute)
+			if tt.lookup(em) {
+				t.Errorf("expired pair was found")
+			}
+			if em.Size() != tt.wantSize {
+				t.Errorf("Size() = %v, want %v", em.Size(), tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestExpiringMap_KeysValues(t *testing.T) {
+	type testCase struct {
+		name       string
+		elapsed    time.Duration
+		wantKeys   []string
+		wantValues []int
+	}
+	tests := []testCase{
+		{"nothing expired", 0, []string{"forever", "long", "short"}, []int{1, 2, 3}},
+		{"short expired", time.Minute, []string{"forever", "long"}, []int{1, 3}},
+		{"all with ttl expired", time.Hour, []string{"forever"}, []int{1}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			clock := newFakeClock()
+			em := sessionMap(clock)
+			clock.advance(tt.elapsed)
+
+			keys := em.Keys()
+			slices.Sort(keys)
+			if !slices.Equal(keys, tt.wantKeys) {
+				t.Errorf("Keys() = %v, want %v", keys, tt.wantKeys)
+			}
+			values := em.Values()
+			slices.Sort(values)
+			if !slices.Equal(values, tt.wantValues) {
+				t.Errorf("Values() = %v, want %v", values, tt.wantValues)
+			}
+		})
+	}
+}
+
+func TestExpiringMap_Sweep(t *testing.T) {
+	type testCase struct {
+		name        string
+		elapsed     time.Duration
+		wantRemoved uint
+		wantSize    uint
+	}
+	tests := []testCase{
+		{"nothing expired", 0, 0, 3},
+		{"one expired", time.Minute, 1, 2},
+		{"all with ttl expired", 2 * time.Hour, 2, 1},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			clock := newFakeClock()
+			em := sessionMap(clock)
+			clock.advance(tt.elapsed)
+
+			if removed, err := em.Sweep(); removed != tt.wantRemoved || err != nil {
+				t.Errorf("Sweep() = %v, %v, want %v, nil", removed, err, tt.wantRemoved)
+			}
+			if em.Size() != tt.wantSize {
+				t.Errorf("Size() = %v, want %v", em.Size(), tt.wantSize)
+			}
+		})
+	}
+	println()
+	t.Run("sweep many pairs", func(t *testing.T) {
+		defer sugar.Lite(t, "sweep many pairs")
+		clock := newFakeClock()
+		em := NewExpiringMapWithClock[int, int](0, clock)
+		for i := range 1000 {
+			_ = em.InsertWithTTL(i, i, time.Duration(i%10)*time.Second)
+		}
+		clock.advance(5 * time.Second)
+		if removed, _ := em.Sweep(); removed != 600 {
+			t.Errorf("Sweep() removed %v, want 600", removed)
+		}
+		for i := range 1000 {
+			if found, _ := em.ContainsKey(i); found != (i%10 > 5) {
+				t.Errorf("ContainsKey(%v) = %v, want %v", i, found, i%10 > 5)
+			}
+		}
+	})
+}
+
+func TestExpiringMap_TTL(t *testing.T) {
+	type testCase struct {
+		name      string
+		ops       func(em *ExpiringMap[string, int])
+		key       string
+		wantTTL   time.Duration
+		wantFound bool
+	}
+	tests := []testCase{
+		{"remaining ttl", func(em *ExpiringMap[string, int]) {}, "long", 50 * time.Minute, true},
+		{"no expiry", func(em *ExpiringMap[string, int]) {}, "forever", NoTTL, true},
+		{"expired", func(em *ExpiringMap[string, int]) {}, "short", 0, false},
+		{"insert replaces ttl", func(em *ExpiringMap[string, int]) { _ = em.InsertWithTTL("short", 2, time.Minute) }, "short", time.Minute, true},
+		{"insert removes ttl", func(em *ExpiringMap[string, int]) { _ = em.Insert("long", 3) }, "long", NoTTL, true},
+		{"ttl <= 0 expires at once", func(em *ExpiringMap[string, int]) { _ = em.InsertWithTTL("long", 3, 0) }, "long", 0, false},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			clock := newFakeClock()
+			em := sessionMap(clock)
+			clock.advance(10 * time.Minute)
+			tt.ops(em)
+			if ttl, found, err := em.TTL(tt.key); ttl != tt.wantTTL || found != tt.wantFound || err != nil {
+				t.Errorf("TTL() = %v, %v, %v, want %v, %v, nil", ttl, found, err, tt.wantTTL, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestExpiringMap_ZeroTimeClock(t *testing.T) {
+	name := "clock at the zero time"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		// The deadline of a ttl of 0 is the zero time, which must not be mistaken for "never expires".
+		em := NewExpiringMapWithClock[int, int](0, &fakeClock{})
+		_ = em.InsertWithTTL(1, 1, 0)
+		_ = em.Insert(2, 2)
+		if got, _ := em.Get(1); got != nil {
+			t.Errorf("Get() = %v, want nil for ttl 0", *got)
+		}
+		if ttl, found, _ := em.TTL(2); !found || ttl != NoTTL {
+			t.Errorf("TTL() = %v, %v, want %v, true", ttl, found, NoTTL)
+		}
+	})
+}
+
+func TestNewExpiringMap_SystemClock(t *testing.T) {
+	println()
+	t.Run("system clock", func(t *testing.T) {
+		defer sugar.Lite(t, "system clock")
+		em := NewExpiringMap[string, int](0)
+		_ = em.InsertWithTTL("a", 1, time.Hour)
+		_ = em.InsertWithTTL("b", 2, -time.Second)
+		if found, _ := em.ContainsKey("a"); !found {
+			t.Errorf("ContainsKey() did not find key with ttl of an hour")
+		}
+		if found, _ := em.ContainsKey("b"); found {
+			t.Errorf("ContainsKey() found key with negative ttl")
+		}
+	})
+}
@@ -1,8 +1,10 @@
 package hashMap
 
 import (
-	"dsa/algorithms/hash"
+	"crypto/rand"
 	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+	"encoding/binary"
+	"math"
 	"reflect"
 )
 
@@ -10,25 +12,86 @@ import (
 Tested:
 With my Murmur3 implementation I get a distribution of about 60% for integers 0-999999. about 40% of buckets are empty.
 The longest linked list has a size of 11
+These numbers can be reproduced with HashMap.Stats() (see playground), also to compare other Hashers.
+
+Incremental rehashing (like Redis does it):
+Resizing does not move all pairs at once, because a single Insert into a map with a million pairs would stall until
+every pair is rehashed. Instead, the old buckets are kept next to the new ones and every writing operation moves
+a few buckets over (rehashStep). While this happens, lookups search both the new and the old buckets.
+New pairs are always inserted into the new buckets.
 */
 
-var seed uint32 = 7757 // Prime number as seed. For a production HashMap, this seed should change randomly to avoid hashMap DoS attacks.
+// rehashBucketsPerStep is the number of non-empty old buckets moved to the new buckets per writing operation.
+// It needs to be high enough to finish rehashing before the next resize, also when shrinking.
+const rehashBucketsPerStep = 8
 
 type HashMap[K, V any] struct {
+	// Pairs are the (new) buckets. New pairs are always inserted here.
 	Pairs []*doublyLinkedListHM.LinkedList[K, V]
-	Size  uint
+	// Size is the number of pairs in Pairs and oldPairs together.
+	Size uint
+	// oldPairs are the buckets which are still being rehashed into Pairs, or nil if no resize is in progress.
+	oldPairs []*doublyLinkedListHM.LinkedList[K, V]
+	// rehashIndex is the next bucket of oldPairs to be moved.
+	rehashIndex int
+	// hasher is nil for the default Murmur3Hasher.
+	hasher Hasher[K]
+	// seed is drawn randomly for every HashMap, so that nobody can precompute keys which all collide into the same bucket
+	// (hash-flooding DoS attack). Murmur3 is not designed against attackers, use NewSipHasher for untrusted keys.
+	seed uint32
+	// load decides when to grow and shrink.
+	load            LoadOptions
+	initialCapacity uint
+	// resizes counts the started resizes for Stats.
+	resizes uint
 }
 
-// NewHashMap creates a new HashMap. Runtime O(n)
+// NewHashMap creates a new HashMap with a random seed. Runtime O(n)
 //
 // initialCapacity - The initial capacity of the HashMap on its creation.
-func NewHashMap[K, V any](initialCapacity uint) *HashMap[K, V] {
+//
+// opts - Optional configuration, e.g. WithHasher to replace the default Murmur3Hasher
+// or WithLoadOptions to change when the HashMap grows and shrinks.
+func NewHashMap[K, V any](initialCapacity uint, opts ...Option[K]) *HashMap[K, V] {
+	return NewHashMapWithSeed[K, V](initialCapacity, randomSeed(), opts...)
+}
+
+// NewHashMapWithSeed creates a new HashMap with a fixed seed. Runtime O(n)
+//
+// Only use this for deterministic tests, because a known seed makes the HashMap vulnerable to hash-flooding.
+//
+// initialCapacity - The initial capacity of the HashMap on its creation.
+//
+// seed - The seed passed to the Hasher for every key.
+//
+// opts - Optional configuration, e.g. WithHasher to replace the default Murmur3Hasher.
+func NewHashMapWithSeed[K, V any](initialCapacity uint, seed uint32, opts ...Option[K]) *HashMap[K, V] {
+	var o options[K]
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return &HashMap[K, V]{
-		make([]*doublyLinkedListHM.LinkedList[K, V], initialCapacity),
-		0,
+		Pairs:           make([]*doublyLinkedListHM.LinkedList[K, V], initialCapacity),
+		Size:            0,
+		hasher:          o.hasher,
+		seed:            seed,
+		load:            o.load,
+		initialCapacity: initialCapacity,
 	}
 }
 
+// NewComparableHashMap creates a new HashMap for comparable keys. Runtime O(n)
+//
+// Instead of encoding every key before hashing it, ints, strings and fixed-size structs are hashed
+// directly from their memory representation and keys are compared with == instead of reflect.DeepEqual.
+// Other key types fall back to the canonical encoding.
+//
+// initialCapacity - The initial capacity of the HashMap on its creation.
+func NewComparableHashMap[K comparable, V any](initialCapacity uint) *HashMap[K, V] {
+	return NewHashMap[K, V](initialCapacity, WithHasher[K](ComparableHasher[K]{}))
+}
+
 // Get value by key. Runtime O(1)
 //
 // Returns nil if no value was found
@@ -37,110 +100,157 @@ func (hm *HashMap[K, V]) Get(key K) (val *V, err error) {
 		return val, nil
 	}
 
-	h, err := hash.Murmur3(key, seed)
-	if err != nil {
+	_, _, node, err := hm.lookup(key)
+	if err != nil || node == nil {
 		return val, err
 	}
-	index := int(h) % len(hm.Pairs)
-
-	ll := hm.Pairs[index]
 
-	node := ll.Head
-	for node != nil {
-		if reflect.DeepEqual(node.Key, key) {
-			return &node.Value, err
-		}
-		node = node.Next
-	}
-
-	return val, err
+	return &node.Value, err
 }
 
 // GetKey by value and whether any key could be found. Runtime O(n)
 //
 // # Returns nil if no key was found
 func (hm *HashMap[K, V]) GetKey(value V) (key *K) {
-	for _, v := range hm.Pairs {
-		if v != nil {
-			node := v.Head
-			for node != nil {
-				if reflect.DeepEqual(node.Value, value) {
-					return &node.Key
-				}
-				node = node.Next
-			}
+	for k, v := range hm.All() {
+		if reflect.DeepEqual(v, value) {
+			return &k
 		}
 	}
 
 	return key
 }
 
-// Insert a key value pair. Runtime average case O(1), worst case O(n) when upsizing.
+// Insert a key value pair or replace the value if key already exists. Runtime average case O(1), worst case O(n) when upsizing.
 //
-// Upsizes the HashMap if HashMap.Size = buckets length to HashMap.Size*2
+// Upsizes the HashMap to HashMap.Size*2 if the new pair would exceed the MaxLoadFactor (by default: HashMap.Size = buckets length).
 func (hm *HashMap[K, V]) Insert(key K, val V) error {
-	// If length of Pairs = HashMap.Size, we want to resize the map by doubling the length of Pairs and recalculate every
-	// key-value pair index
-	if len(hm.Pairs) == int(hm.Size) {
-		err := resizeHM(hm)
-		// In the current implementation, this error will never be reached.
-		// Leaving it in case the implementation of resizeHM changes, to be safe.
-		if err != nil {
-			return err
-		}
+	hm.rehashStep()
+	h, _, node, err := hm.lookup(key)
+	if err != nil {
+		return err
+	}
+
+	if node != nil {
+		node.Value = val
+		return nil
+	}
+
+	_, err = hm.insertNew(key, val, h)
+	return err
+}
+
+// InsertIfAbsent inserts the key value pair only if key does not exist yet. Runtime average case O(1), worst case O(n) when upsizing.
+//
+// Returns whether the pair was inserted.
+func (hm *HashMap[K, V]) InsertIfAbsent(key K, val V) (inserted bool, err error) {
+	hm.rehashStep()
+	h, _, node, err := hm.lookup(key)
+	if err != nil || node != nil {
+		return false, err
 	}
 
-	h, err := hash.Murmur3(key, seed)
+	_, err = hm.insertNew(key, val, h)
+	return err == nil, err
+}
+
+// GetOrInsert returns the value of key, or inserts val if key does not exist yet. Runtime average case O(1), worst case O(n) when upsizing.
+//
+// Returns the value stored for key and whether it was already there (loaded) or val was inserted.
+func (hm *HashMap[K, V]) GetOrInsert(key K, val V) (actual *V, loaded bool, err error) {
+	hm.rehashStep()
+	h, _, node, err := hm.lookup(key)
 	if err != nil {
-		return err
+		return actual, false, err
 	}
 
-	index := int(h) % len(hm.Pairs)
+	if node != nil {
+		return &node.Value, true, nil
+	}
 
-	if hm.Pairs[index] == nil {
-		hm.Pairs[index] = doublyLinkedListHM.NewLinkedList[K, V](key, val)
-	} else {
-		hm.Pairs[index].Push(key, val)
+	node, err = hm.insertNew(key, val, h)
+	if err != nil {
+		return actual, false, err
 	}
-	hm.Size++
-	return nil
+	return &node.Value, false, nil
+}
+
+// GetOrInsertFunc returns the value of key, or inserts the result of newVal if key does not exist yet.
+// Runtime average case O(1), worst case O(n) when upsizing.
+//
+// Unlike GetOrInsert, the value is only created if it is inserted, e.g. to avoid allocating a new list for every call.
+//
+// Returns the value stored for key and whether it was already there (loaded) or the result of newVal was inserted.
+func (hm *HashMap[K, V]) GetOrInsertFunc(key K, newVal func() V) (actual *V, loaded bool, err error) {
+	hm.rehashStep()
+	h, _, node, err := hm.lookup(key)
+	if err != nil {
+		return actual, false, err
+	}
+
+	if node != nil {
+		return &node.Value, true, nil
+	}
+
+	node, err = hm.insertNew(key, newVal(), h)
+	if err != nil {
+		return actual, false, err
+	}
+	return &node.Value, false, nil
+}
+
+// Update sets the value of key to the result of fn. Runtime average case O(1), worst case O(n) when upsizing.
+//
+// fn gets the current value of key and whether key exists. If key does not exist, old is the zero value of V
+// and the result of fn is inserted.
+func (hm *HashMap[K, V]) Update(key K, fn func(old V, ok bool) V) error {
+	hm.rehashStep()
+	h, _, node, err := hm.lookup(key)
+	if err != nil {
+		return err
+	}
+
+	if node != nil {
+		node.Value = fn(node.Value, true)
+		return nil
+	}
+
+	var zero V
+	_, err = hm.insertNew(key, fn(zero, false), h)
+	return err
 }
 
 // Remove key value pair by key. Runtime average case: O(1), worst case O(n)
 //
 // Returns the value or nil if no value was found.
 //
-// Resizing HashMap if map size / 4 = HashMap.Size to HashMap.Size * 2.
+// Resizing HashMap to HashMap.Size * 2 if HashMap.Size drops to the MinLoadFactor (by default: map size / 4 = HashMap.Size).
 func (hm *HashMap[K, V]) Remove(key K) (val *V, err error) {
 	if hm.Size <= 0 {
 		return val, err
 	}
 
-	h, err := hash.Murmur3(key, seed)
-	if err != nil {
-		return val, err
-	}
-	index := int(h) % len(hm.Pairs)
-
-	value, found := hm.Pairs[index].Remove(key)
-	if !found {
+	hm.rehashStep()
+	_, bucket, node, err := hm.lookup(key)
+	if err != nil || node == nil {
 		return val, err
 	}
+	value := node.Value
+	(*bucket).RemoveNode(node)
 
 	hm.Size--
 	// deleting empty linkedLists
-	if hm.Pairs[index].Size <= 0 {
-		hm.Pairs[index] = nil
+	if (*bucket).Size <= 0 {
+		*bucket = nil
 	}
 
 	// If length of Pairs / 4 = HashMap.Size, we want to resize the map to have double the length of Pairs and recalculate every
 	// key-value pair index
 	// This is just my approach to do it. no general rule. Resizing happens effectively at a load factor of 0.25 to be 0.5 after wards.
-	// Note: A probably valid thing here would be to NOT downsize, if the Pairs length is the same or less than the initialCapacity.
-	// 		 Also, many implementations of maps in other languages seem to not even consider downsizing of maps. They just never downsize.
-	if len(hm.Pairs)>>2 >= int(hm.Size) {
-		// Error is safe to ignore because it will be already caught at the hashing step of this function.
-		resizeHM(hm)
+	// Note: LoadOptions can change the load factors, keep the initialCapacity as the smallest size,
+	// 		 or disable downsizing like many implementations of maps in other languages do.
+	if hm.shouldShrink() {
+		resizeHM(hm, hm.targetBuckets())
 	}
 
 	return &value, err
@@ -152,23 +262,8 @@ func (hm *HashMap[K, V]) ContainsKey(key K) (bool, error) {
 		return false, nil
 	}
 
-	h, err := hash.Murmur3(key, seed)
-	if err != nil {
-		return false, err
-	}
-	index := int(h) % len(hm.Pairs)
-
-	ll := hm.Pairs[index]
-
-	node := ll.Head
-	for node != nil {
-		if reflect.DeepEqual(node.Key, key) {
-			return true, err
-		}
-		node = node.Next
-	}
-
-	return false, err
+	_, _, node, err := hm.lookup(key)
+	return node != nil, err
 }
 
 // ContainsVal - Check if value exists. Runtime O(n)
@@ -191,12 +286,8 @@ func (hm *HashMap[K, V]) IsEmpty() bool {
 func (hm *HashMap[K, V]) Keys() []K {
 	keys := make([]K, 0)
 
-	for _, p := range hm.Pairs {
-		node := p.Head
-		for node != nil {
-			keys = append(keys, node.Key)
-			node = node.Next
-		}
+	for k := range hm.All() {
+		keys = append(keys, k)
 	}
 
 	return keys
@@ -206,12 +297,8 @@ func (hm *HashMap[K, V]) Keys() []K {
 func (hm *HashMap[K, V]) Values() []V {
 	values := make([]V, 0)
 
-	for _, p := range hm.Pairs {
-		node := p.Head
-		for node != nil {
-			values = append(values, node.Value)
-			node = node.Next
-		}
+	for _, v := range hm.All() {
+		values = append(values, v)
 	}
 
 	return values
@@ -225,39 +312,226 @@ func (hm *HashMap[K, V]) Clear() {
 	// - provide a parameter with initalCapacity
 	// - initialize with the same size as the last HashMap (however, this would be to much memory, and also,
 	//	 the way I resize it wouldn't make any sense, because the first Remove, would massively downsize it.
-	// - Resize to the original size.
-	hm.Pairs = make([]*doublyLinkedListHM.LinkedList[K, V], 1)
+	// - Resize to the original size. (Done with LoadOptions.KeepInitialCapacity)
+	var size uint = 1
+	if hm.load.KeepInitialCapacity {
+		size = max(size, hm.initialCapacity)
+	}
+	hm.Pairs = make([]*doublyLinkedListHM.LinkedList[K, V], size)
 	hm.Size = 0
+	hm.oldPairs = nil
+	hm.rehashIndex = 0
 }
 
-// Runtime O(n).
+// Reserve makes room for n pairs, so that inserting up to n pairs does not resize the HashMap. Runtime O(n)
 //
-// Resizes a HashMap to HashMap.Size * 2.
-func resizeHM[K, V any](hm *HashMap[K, V]) (err error) {
-	var newSize uint = 0
-	if hm.Size == 0 {
-		newSize = 1
-	} else {
-		newSize = hm.Size * 2
+// Use it before bulk inserts. The rehashing is done right away instead of incrementally.
+func (hm *HashMap[K, V]) Reserve(n uint) {
+	if n == 0 {
+		return
+	}
+
+	needed := uint(math.Ceil(float64(n) / hm.load.maxLoad()))
+	// Rounding can make needed one bucket too small, e.g. 63 / 0.7 = 90, but 0.7 * 90 < 63.
+	// Check with the same float arithmetic as shouldGrow, so that the n-th insert does not resize.
+	for hm.load.exceedsMaxLoad(n, needed) {
+		needed++
 	}
+	if uint(len(hm.Pairs)) >= needed {
+		return
+	}
+
+	resizeHM(hm, needed)
+	hm.finishRehash()
+}
+
+// Runtime O(1), or O(n) if the previous resize is not finished yet.
+//
+// Starts resizing a HashMap to newSize buckets. The pairs are moved into the new buckets incrementally by rehashStep.
+func resizeHM[K, V any](hm *HashMap[K, V], newSize uint) {
+	// Only one resize can be in progress at a time.
+	hm.finishRehash()
+	hm.resizes++
 
 	newPairs := make([]*doublyLinkedListHM.LinkedList[K, V], newSize)
-	oldPairs := hm.Pairs
+	if hm.Size == 0 {
+		// Nothing to move.
+		hm.Pairs = newPairs
+		return
+	}
+
+	hm.oldPairs = hm.Pairs
+	hm.rehashIndex = 0
 	hm.Pairs = newPairs
-	hm.Size = 0
+}
+
+// shouldGrow reports whether one more pair would exceed the MaxLoadFactor.
+func (hm *HashMap[K, V]) shouldGrow() bool {
+	return hm.load.exceedsMaxLoad(hm.Size+1, uint(len(hm.Pairs)))
+}
+
+// shouldShrink reports whether Size dropped to the MinLoadFactor and shrinking is allowed.
+func (hm *HashMap[K, V]) shouldShrink() bool {
+	if hm.load.DisableShrink || (hm.load.KeepInitialCapacity && uint(len(hm.Pairs)) <= hm.initialCapacity) {
+		return false
+	}
+	return float64(hm.Size) <= hm.load.minLoad()*float64(len(hm.Pairs))
+}
 
-	for _, v := range oldPairs {
-		if v != nil {
-			node := v.Head
-			for node != nil {
-				err := hm.Insert(node.Key, node.Value)
-				// Inverted error check here to trick 100% test coverage,
-				// because the current semi recursive call of hm.Insert would make the error case not reachable.
-				if err == nil {
-					node = node.Next
-				}
-			}
+// targetBuckets returns the number of buckets to resize to, so that the HashMap is at half of its MaxLoadFactor.
+// By default, this is HashMap.Size * 2.
+//
+// There is always room for at least one more pair, which matters for small maps.
+func (hm *HashMap[K, V]) targetBuckets() uint {
+	maxLoad := hm.load.maxLoad()
+	size := max(uint(math.Ceil(float64(hm.Size)*2/maxLoad)), uint(math.Ceil(float64(hm.Size+1)/maxLoad)))
+	if hm.load.KeepInitialCapacity {
+		size = max(size, hm.initialCapacity)
+	}
+	return size
+}
+
+// rehashStep moves up to rehashBucketsPerStep non-empty buckets from oldPairs into Pairs. Runtime O(1)
+//
+// To keep the runtime bounded, at most 10 times as many empty buckets are skipped.
+func (hm *HashMap[K, V]) rehashStep() {
+	if hm.oldPairs == nil {
+		return
+	}
+
+	emptyVisits := rehashBucketsPerStep * 10
+	for moved := 0; moved < rehashBucketsPerStep && emptyVisits > 0 && hm.rehashIndex < len(hm.oldPairs); hm.rehashIndex++ {
+		if hm.oldPairs[hm.rehashIndex] == nil {
+			emptyVisits--
+			continue
 		}
+		hm.moveBucket(hm.rehashIndex)
+		moved++
 	}
-	return err
+
+	if hm.rehashIndex >= len(hm.oldPairs) {
+		hm.oldPairs = nil
+		hm.rehashIndex = 0
+	}
+}
+
+// finishRehash moves all remaining buckets from oldPairs into Pairs. Runtime O(n)
+func (hm *HashMap[K, V]) finishRehash() {
+	if hm.oldPairs == nil {
+		return
+	}
+
+	for ; hm.rehashIndex < len(hm.oldPairs); hm.rehashIndex++ {
+		if hm.oldPairs[hm.rehashIndex] != nil {
+			hm.moveBucket(hm.rehashIndex)
+		}
+	}
+	hm.oldPairs = nil
+	hm.rehashIndex = 0
+}
+
+// moveBucket moves every node of oldPairs[i] into its bucket in Pairs without allocating new nodes.
+func (hm *HashMap[K, V]) moveBucket(i int) {
+	node := hm.oldPairs[i].Head
+	for node != nil {
+		next := node.Next
+		// The key was hashed successfully when it was inserted, so hashing it again can not fail.
+		h, _ := hm.Hasher().Hash(node.Key, hm.seed)
+		index := bucketIndex(h, len(hm.Pairs))
+		if hm.Pairs[index] == nil {
+			hm.Pairs[index] = &doublyLinkedListHM.LinkedList[K, V]{}
+		}
+		hm.Pairs[index].PushNode(node)
+		node = next
+	}
+	hm.oldPairs[i] = nil
+}
+
+// Seed returns the seed of the HashMap.
+func (hm *HashMap[K, V]) Seed() uint32 {
+	return hm.seed
+}
+
+// Hasher returns the Hasher used by the HashMap.
+func (hm *HashMap[K, V]) Hasher() Hasher[K] {
+	if hm.hasher == nil {
+		return Murmur3Hasher[K]{}
+	}
+	return hm.hasher
+}
+
+// randomSeed draws a seed from crypto/rand.
+func randomSeed() uint32 {
+	var b [4]byte
+	// crypto/rand only fails if the operating system can not provide randomness at all.
+	// Continuing with a predictable seed would silently remove the hash-flooding protection.
+	if _, err := rand.Read(b[:]); err != nil {
+		panic("hashMap: could not draw a random seed: " + err.Error())
+	}
+	return binary.LittleEndian.Uint32(b[:])
+}
+
+// lookup hashes key once and returns its hash, its bucket and its node, or nil if key does not exist.
+//
+// While rehashing, the key is searched in the new buckets first and then in the old buckets.
+func (hm *HashMap[K, V]) lookup(key K) (h uint32, bucket **doublyLinkedListHM.LinkedList[K, V], node *doublyLinkedListHM.Node[K, V], err error) {
+	h, err = hm.Hasher().Hash(key, hm.seed)
+	if err != nil || len(hm.Pairs) == 0 {
+		return h, nil, nil, err
+	}
+
+	bucket = &hm.Pairs[bucketIndex(h, len(hm.Pairs))]
+	if node = hm.findNode(*bucket, key); node != nil || hm.oldPairs == nil {
+		return h, bucket, node, nil
+	}
+
+	bucket = &hm.oldPairs[bucketIndex(h, len(hm.oldPairs))]
+	return h, bucket, hm.findNode(*bucket, key), nil
+}
+
+// insertNew pushes a key value pair, whose key does not exist yet, into its bucket. h is the hash of key.
+//
+// Returns the new node.
+func (hm *HashMap[K, V]) insertNew(key K, val V, h uint32) (*doublyLinkedListHM.Node[K, V], error) {
+	// If length of Pairs = HashMap.Size (at the default MaxLoadFactor of 1), we want to resize the map by doubling the
+	// length of Pairs and recalculate every key-value pair index
+	if hm.shouldGrow() {
+		resizeHM(hm, hm.targetBuckets())
+	}
+
+	index := bucketIndex(h, len(hm.Pairs))
+
+	if hm.Pairs[index] == nil {
+		hm.Pairs[index] = &doublyLinkedListHM.LinkedList[K, V]{}
+	}
+	hm.Size++
+	return hm.Pairs[index].Push(key, val), nil
+}
+
+// bucketIndex maps the hash h to one of n buckets.
+//
+// Converting h to int before the modulo would produce negative indices on 32-bit platforms, where int has 32 bits.
+func bucketIndex(h uint32, n int) int {
+	return int(uint(h) % uint(n))
+}
+
+// keysEqual compares two keys with the equality of the HashMap's Hasher.
+func (hm *HashMap[K, V]) keysEqual(a, b K) bool {
+	return hm.Hasher().Equal(a, b)
+}
+
+// findNode returns the node with key in bucket ll, or nil if key is not in the bucket.
+func (hm *HashMap[K, V]) findNode(ll *doublyLinkedListHM.LinkedList[K, V], key K) *doublyLinkedListHM.Node[K, V] {
+	if ll == nil {
+		return nil
+	}
+
+	node := ll.Head
+	for node != nil {
+		if hm.keysEqual(node.Key, key) {
+			return node
+		}
+		node = node.Next
+	}
+	return nil
 }
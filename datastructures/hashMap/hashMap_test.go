@@ -3,7 +3,9 @@ package hashMap
 import (
 	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
 	"dsa/util/sugar"
+	"math"
 	"reflect"
+	"strconv"
 	"testing"
 )
 
@@ -23,18 +25,18 @@ func ll[K any, V any](keys []K, vals []V) *doublyLinkedListHM.LinkedList[K, V] {
 		var key K
 		var val V
 		return &doublyLinkedListHM.LinkedList[K, V]{
-			Head: &doublyLinkedListHM.Node[K, V]{key, val, nil, nil},
+			Head: &doublyLinkedListHM.Node[K, V]{Key: key, Value: val},
 			Size: 1,
 		}
 	} else if len(keys) == 0 {
 		return &doublyLinkedListHM.LinkedList[K, V]{Head: nil, Size: 0}
 	}
 
-	node := &doublyLinkedListHM.Node[K, V]{keys[len(keys)-1], vals[len(vals)-1], nil, nil}
-	ll := &doublyLinkedListHM.LinkedList[K, V]{node, uint(len(keys))}
+	node := &doublyLinkedListHM.Node[K, V]{Key: keys[len(keys)-1], Value: vals[len(vals)-1]}
+	ll := &doublyLinkedListHM.LinkedList[K, V]{Head: node, Size: uint(len(keys))}
 
 	for i := len(keys) - 2; i > -1; i-- {
-		newNode := &doublyLinkedListHM.Node[K, V]{keys[i], vals[i], nil, node}
+		newNode := &doublyLinkedListHM.Node[K, V]{Key: keys[i], Value: vals[i], Prev: node}
 		node.Next = newNode
 		node = newNode
 	}
@@ -42,6 +44,9 @@ func ll[K any, V any](keys []K, vals []V) *doublyLinkedListHM.LinkedList[K, V] {
 	return ll
 }
 
+// testSeed is the seed all test HashMaps are created with, so that the bucket of every key is known.
+const testSeed uint32 = 7757
+
 func intP(i int) *int {
 	return &i
 }
@@ -115,26 +120,31 @@ func TestHashMap_Clear(t *testing.T) {
 	tests := []testCase[int, int]{
 		{
 			"empty map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{nil}, 0}, // Putting nil in ll slice because I clear maps to have a length 1
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{nil}, Size: 0}, // Putting nil in ll slice because I clear maps to have a length 1
 		},
 		{
 			"filled map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{1}),
 				ll[int, int]([]int{2, 3}, []int{2, 3}),
-			}, 3},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{nil}, 0}, // Putting nil in ll slice because I clear maps to have a length 1
+			}, Size: 3},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{nil}, Size: 0}, // Putting nil in ll slice because I clear maps to have a length 1
 		},
 	}
 	for _, tt := range tests {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
+			rh := robinHoodFrom(tt.hm)
 			tt.hm.Clear()
 			if !reflect.DeepEqual(tt.hm, tt.wantHM) {
 				t.Errorf("Clear(), hm %v, wantHM %v", tt.hm, tt.wantHM)
 			}
+			rh.Clear()
+			if !rh.IsEmpty() || len(rh.Keys()) != 0 {
+				t.Errorf("RobinHoodHashMap.Clear(), rh %v", rh)
+			}
 		})
 	}
 }
@@ -153,46 +163,46 @@ func TestHashMap_ContainsKey(t *testing.T) {
 	tests := []testCase[int, int]{
 		{
 			"emtpy map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			args[int]{1},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			false,
 		},
 		{
 			"not found",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			args[int]{4},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			false,
 		},
 		{
 			"found",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			args[int]{1},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			true,
 		},
 	}
-	testError := []testCase[chan int, chan int]{
+	testError := []testCase[func(), func()]{
 		{
-			// My hashing function does not support values of type channel
+			// My hashing function does not support non-nil values of type func
 			"test error",
-			&HashMap[chan int, chan int]{[]*doublyLinkedListHM.LinkedList[chan int, chan int]{
-				ll[chan int, chan int]([]chan int{}, []chan int{}),
-			}, 1},
-			args[chan int]{nil},
+			&HashMap[func(), func()]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[func(), func()]{
+				ll[func(), func()]([]func(){}, []func(){}),
+			}, Size: 1},
+			args[func()]{func() {}},
 			nil, // We do not care for it in this test.
 			false,
 		},
@@ -201,9 +211,13 @@ func TestHashMap_ContainsKey(t *testing.T) {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
+			rh := robinHoodFrom(tt.hm)
 			if gotFound, _ := tt.hm.ContainsKey(tt.args.key); gotFound != tt.wantFound {
 				t.Errorf("ContainsKey() = %v, wantFound %v", gotFound, tt.wantFound)
 			}
+			if gotFound, _ := rh.ContainsKey(tt.args.key); gotFound != tt.wantFound {
+				t.Errorf("RobinHoodHashMap.ContainsKey() = %v, wantFound %v", gotFound, tt.wantFound)
+			}
 			if !reflect.DeepEqual(tt.hm, tt.wantHM) {
 				t.Errorf("ContainsKey(), hm %v, wantHM %v", tt.hm, tt.wantHM)
 			}
@@ -213,10 +227,14 @@ func TestHashMap_ContainsKey(t *testing.T) {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
+			rh := robinHoodFrom(tt.hm)
 			_, err := tt.hm.ContainsKey(tt.args.key)
 			if err == nil {
 				t.Errorf("test %v should have thrown error. Got %v", tt.name, err)
 			}
+			if _, err := rh.ContainsKey(tt.args.key); err == nil {
+				t.Errorf("test %v should have thrown error for RobinHoodHashMap. Got %v", tt.name, err)
+			}
 		})
 	}
 }
@@ -235,35 +253,35 @@ func TestHashMap_ContainsVal(t *testing.T) {
 	tests := []testCase[int, int]{
 		{
 			"emtpy map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			args{1},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			false,
 		},
 		{
 			"not found",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			args{2},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			false,
 		},
 		{
 			"found",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			args{6},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			true,
 		},
 	}
@@ -295,46 +313,46 @@ func TestHashMap_Get(t *testing.T) {
 	tests := []testCase[int, int]{
 		{
 			"emtpy map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			args[int]{1},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			nil,
 		},
 		{
 			"not found",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			args[int]{4},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			nil,
 		},
 		{
 			"found",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1, 3}, []int{4, 6}), // hash algorithm will place k,v at index 0.
 				ll[int, int]([]int{2}, []int{5}),
-			}, 3},
+			}, Size: 3},
 			args[int]{3},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1, 3}, []int{4, 6}),
 				ll[int, int]([]int{2}, []int{5}),
-			}, 3},
+			}, Size: 3},
 			intP(6),
 		},
 	}
-	testError := []testCase[chan int, chan int]{
+	testError := []testCase[func(), func()]{
 		{
-			// My hashing function does not support values of type channel
+			// My hashing function does not support non-nil values of type func
 			"test error",
-			&HashMap[chan int, chan int]{[]*doublyLinkedListHM.LinkedList[chan int, chan int]{
-				ll[chan int, chan int]([]chan int{}, []chan int{}),
-			}, 1},
-			args[chan int]{nil},
+			&HashMap[func(), func()]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[func(), func()]{
+				ll[func(), func()]([]func(){}, []func(){}),
+			}, Size: 1},
+			args[func()]{func() {}},
 			nil, // We do not care for it in this test.
 			nil,
 		},
@@ -343,6 +361,7 @@ func TestHashMap_Get(t *testing.T) {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
+			rh := robinHoodFrom(tt.hm)
 			gotVal, err := tt.hm.Get(tt.args.key)
 			if err != nil {
 				t.Errorf("Hash function threw error: %v", err)
@@ -350,6 +369,9 @@ func TestHashMap_Get(t *testing.T) {
 			if !reflect.DeepEqual(gotVal, tt.wantVal) {
 				t.Errorf("Get() gotVal = %v, want %v", gotVal, tt.wantVal)
 			}
+			if gotVal, _ := rh.Get(tt.args.key); !reflect.DeepEqual(gotVal, tt.wantVal) {
+				t.Errorf("RobinHoodHashMap.Get() gotVal = %v, want %v", gotVal, tt.wantVal)
+			}
 			if !reflect.DeepEqual(tt.hm, tt.wantHM) {
 				t.Errorf("Get(), hm %v, wantHM %v", tt.hm, tt.wantHM)
 			}
@@ -359,10 +381,14 @@ func TestHashMap_Get(t *testing.T) {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
+			rh := robinHoodFrom(tt.hm)
 			_, err := tt.hm.Get(tt.args.key)
 			if err == nil {
 				t.Errorf("test %v should have thrown error. Got %v", tt.name, err)
 			}
+			if _, err := rh.Get(tt.args.key); err == nil {
+				t.Errorf("test %v should have thrown error for RobinHoodHashMap. Got %v", tt.name, err)
+			}
 		})
 	}
 }
@@ -381,35 +407,35 @@ func TestHashMap_GetKey(t *testing.T) {
 	tests := []testCase[int, int]{
 		{
 			"emtpy map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			args{1},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			nil,
 		},
 		{
 			"not found",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			args{2},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			nil,
 		},
 		{
 			"found",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1, 3}, []int{4, 6}), // hash algorithm will place k,v at index 0.
 				ll[int, int]([]int{2}, []int{5}),
-			}, 3},
+			}, Size: 3},
 			args{6},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1, 3}, []int{4, 6}),
 				ll[int, int]([]int{2}, []int{5}),
-			}, 3},
+			}, Size: 3},
 			intP(3),
 		},
 	}
@@ -438,20 +464,20 @@ func TestHashMap_IsEmpty(t *testing.T) {
 	tests := []testCase[int, int]{
 		{
 			"emtpy map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			true,
 		},
 		{
 			"filled map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			}, Size: 3},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			false,
 		},
 	}
@@ -478,20 +504,20 @@ func TestHashMap_Keys(t *testing.T) {
 	tests := []testCase[int, int]{
 		{
 			"emtpy map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			make([]int, 0),
 		},
 		{
 			"filled map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			}, Size: 3},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			[]int{1, 2, 3},
 		},
 	}
@@ -499,9 +525,13 @@ func TestHashMap_Keys(t *testing.T) {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
+			rh := robinHoodFrom(tt.hm)
 			if got := tt.hm.Keys(); !slicesEqualUnordered(got, tt.wantKeys) {
 				t.Errorf("Keys() = %v, wantKeys %v", got, tt.wantKeys)
 			}
+			if got := rh.Keys(); !slicesEqualUnordered(got, tt.wantKeys) {
+				t.Errorf("RobinHoodHashMap.Keys() = %v, wantKeys %v", got, tt.wantKeys)
+			}
 			if !reflect.DeepEqual(tt.hm, tt.wantHM) {
 				t.Errorf("Keys(), hm %v, wantHM %v", tt.hm, tt.wantHM)
 			}
@@ -519,20 +549,20 @@ func TestHashMap_Values(t *testing.T) {
 	tests := []testCase[int, int]{
 		{
 			"emtpy map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			make([]int, 0),
 		},
 		{
 			"filled map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			}, Size: 3},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{4}),
 				ll[int, int]([]int{2, 3}, []int{5, 6}),
-			}, 3},
+			}, Size: 3},
 			[]int{4, 5, 6},
 		},
 	}
@@ -540,9 +570,13 @@ func TestHashMap_Values(t *testing.T) {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
+			rh := robinHoodFrom(tt.hm)
 			if got := tt.hm.Values(); !slicesEqualUnordered(got, tt.wantValues) {
 				t.Errorf("Values() = %v, wantValues %v", got, tt.wantValues)
 			}
+			if got := rh.Values(); !slicesEqualUnordered(got, tt.wantValues) {
+				t.Errorf("RobinHoodHashMap.Values() = %v, wantValues %v", got, tt.wantValues)
+			}
 			if !reflect.DeepEqual(tt.hm, tt.wantHM) {
 				t.Errorf("Values(), hm %v, wantHM %v", tt.hm, tt.wantHM)
 			}
@@ -562,32 +596,71 @@ func TestNewHashMap(t *testing.T) {
 		{
 			"size 0",
 			args{0},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 		},
 		{
 			"size 1",
 			args{1},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{nil}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{nil}, Size: 0, initialCapacity: 1},
 		},
 		{
 			"size 10",
 			args{10},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
-			}, 0},
+			}, Size: 0, initialCapacity: 10},
 		},
 	}
 	for _, tt := range tests {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
-			if got := NewHashMap[int, int](tt.args.initialCapacity); !reflect.DeepEqual(got, tt.wantHM) {
+			got := NewHashMap[int, int](tt.args.initialCapacity)
+			// The seed is random, so it is the only field which is not compared.
+			got.seed = testSeed
+			if !reflect.DeepEqual(got, tt.wantHM) {
 				t.Errorf("NewHashMap(), hm %v, wantHM %v", got, tt.wantHM)
 			}
+			if got := NewHashMapWithSeed[int, int](tt.args.initialCapacity, testSeed); !reflect.DeepEqual(got, tt.wantHM) {
+				t.Errorf("NewHashMapWithSeed(), hm %v, wantHM %v", got, tt.wantHM)
+			}
 		})
 	}
 }
 
+func TestNewHashMap_RandomSeed(t *testing.T) {
+	println()
+	t.Run("maps get different seeds", func(t *testing.T) {
+		defer sugar.Lite(t, "maps get different seeds")
+		// Drawing the same random seed 4 times in a row is practically impossible.
+		seeds := make(map[uint32]bool)
+		for range 4 {
+			seeds[NewHashMap[int, int](0).Seed()] = true
+		}
+		if len(seeds) == 1 {
+			t.Errorf("NewHashMap() drew the same seed 4 times: %v", seeds)
+		}
+	})
+	println()
+	t.Run("seed survives resizing", func(t *testing.T) {
+		defer sugar.Lite(t, "seed survives resizing")
+		hm := NewHashMap[string, int](0)
+		s := hm.Seed()
+		for i, k := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+			hm.Insert(k, i)
+		}
+		hm.Remove("a")
+		if hm.Seed() != s {
+			t.Errorf("Seed() = %v, want %v", hm.Seed(), s)
+		}
+		for i, k := range []string{"b", "c", "d", "e", "f", "g"} {
+			if got, _ := hm.Get(k); got == nil || *got != i+1 {
+				t.Errorf("Get(%v) got = %v, want %v", k, got, i+1)
+			}
+		}
+	})
+}
+
 func TestHashMap_Insert(t *testing.T) {
 	type args[K, V any] struct {
 		key K
@@ -603,47 +676,47 @@ func TestHashMap_Insert(t *testing.T) {
 	tests := []testCase[int, int]{
 		{
 			"into empty map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			args[int, int]{1, 2},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{2}),
-			}, 1},
+			}, Size: 1},
 			1,
 		},
 		{
 			"into map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{2}),
-			}, 1},
+			}, Size: 1},
 			args[int, int]{3, 4},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1, 3}, []int{2, 4}),
-			}, 2},
+			}, Size: 2},
 			2,
 		},
 		{
 			"upsize map",
 			// it is important for the test, that the Pairs length starts with 3
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{1}),
 				ll[int, int]([]int{2, 3}, []int{2, 3}),
 				nil,
-			}, 3},
+			}, Size: 3},
 			args[int, int]{4, 4},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1, 2, 3, 4}, []int{1, 2, 3, 4}),
-			}, 4},
+			}, Size: 4},
 			6, // we double the array size if it is full, so we need to get a len of 6 here.
 		},
 	}
-	testError := []testCase[chan int, chan int]{
+	testError := []testCase[func(), func()]{
 		{
-			// My hashing function does not support values of type channel
+			// My hashing function does not support non-nil values of type func
 			"test error",
-			&HashMap[chan int, chan int]{[]*doublyLinkedListHM.LinkedList[chan int, chan int]{
-				ll[chan int, chan int]([]chan int{}, []chan int{}),
-			}, 0},
-			args[chan int, chan int]{nil, nil},
+			&HashMap[func(), func()]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[func(), func()]{
+				ll[func(), func()]([]func(){}, []func(){}),
+			}, Size: 0},
+			args[func(), func()]{func() {}, nil},
 			nil, // We do not care for it in this test.
 			0,   // We do not care for it in this test.
 		},
@@ -652,10 +725,15 @@ func TestHashMap_Insert(t *testing.T) {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
+			rh := robinHoodFrom(tt.hm)
 			tt.hm.Insert(tt.args.key, tt.args.val)
 			if !mapsEqual(tt.hm, tt.wantHM) {
 				t.Errorf("Insert(), hm %v, wantHM %v", tt.hm, tt.wantHM)
 			}
+			rh.Insert(tt.args.key, tt.args.val)
+			if !robinHoodEqual(rh, tt.wantHM) {
+				t.Errorf("RobinHoodHashMap.Insert(), rh %v, wantHM %v", rh, tt.wantHM)
+			}
 			if len(tt.hm.Pairs) != tt.wantPairsSize {
 				t.Errorf("Insert(), gotPairsSize %v, wantPairsSize %v", len(tt.hm.Pairs), tt.wantPairsSize)
 			}
@@ -665,10 +743,14 @@ func TestHashMap_Insert(t *testing.T) {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
+			rh := robinHoodFrom(tt.hm)
 			err := tt.hm.Insert(tt.args.key, tt.args.val)
 			if err == nil {
 				t.Errorf("test %v should have thrown error. Got %v", tt.name, err)
 			}
+			if err := rh.Insert(tt.args.key, tt.args.val); err == nil {
+				t.Errorf("test %v should have thrown error for RobinHoodHashMap. Got %v", tt.name, err)
+			}
 		})
 	}
 }
@@ -687,86 +769,95 @@ func TestHashMap_Remove(t *testing.T) {
 	tests := []testCase[int, int]{
 		{
 			"empty map",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			args[int]{1},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			nil,
 		},
 		{
 			"remove from map size 1",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{2}),
-			}, 1},
+			}, Size: 1},
 			args[int]{1},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{}, 0},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
 			intP(2),
 		},
 		{
 			"remove from map size 4 with ll.Size = 1",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
-				ll[int, int]([]int{1, 2}, []int{1, 2}),
-				ll[int, int]([]int{4}, []int{4}),
-				ll[int, int]([]int{3}, []int{3}), // need to place value here because hashing will place key 3 at index 2
-			}, 4},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
+				ll[int, int]([]int{3}, []int{3}), // need to place value here because hashing will place key 3 at index 0
+				ll[int, int]([]int{2, 4}, []int{2, 4}),
+				ll[int, int]([]int{1}, []int{1}),
+			}, Size: 4},
 			args[int]{3},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
-				ll[int, int]([]int{1, 2}, []int{1, 2}),
-				ll[int, int]([]int{4}, []int{4}),
-			}, 3},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
+				nil,
+				ll[int, int]([]int{2, 4}, []int{2, 4}),
+				ll[int, int]([]int{1}, []int{1}),
+			}, Size: 3},
 			intP(3),
 		},
 		{
 			"remove from map size 4 with ll.Size > 1",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{3}, []int{3}),
-				ll[int, int]([]int{4}, []int{4}),
-				ll[int, int]([]int{1, 2}, []int{1, 2}), // need to place value here because hashing will place key 2 at index 2
-			}, 4},
-			args[int]{2},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+				ll[int, int]([]int{2, 4}, []int{2, 4}), // need to place value here because hashing will place key 2 at index 1
 				ll[int, int]([]int{1}, []int{1}),
+			}, Size: 4},
+			args[int]{2},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{3}, []int{3}),
 				ll[int, int]([]int{4}, []int{4}),
-			}, 3},
+				ll[int, int]([]int{1}, []int{1}),
+			}, Size: 3},
 			intP(2),
 		},
 		{
 			"try remove not existing key",
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{2}),
-			}, 1},
+			}, Size: 1},
 			args[int]{2},
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
 				ll[int, int]([]int{1}, []int{2}),
-			}, 1},
+			}, Size: 1},
 			nil,
 		},
 		{
 			"downsize map",
 			// Create an initial HashMap with a Paris array of size 20:
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
-				ll[int, int]([]int{3, 4, 5}, []int{3, 4, 5}), // need to place value here because hashing will place key 5 at index 8
-				ll[int, int]([]int{1, 2}, []int{1, 2}),
-				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, // 18 empty buckets
-			}, 5},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
+				nil, nil, nil,
+				ll[int, int]([]int{4, 5}, []int{4, 5}), // need to place value here because hashing will place key 5 at index 3
+				ll[int, int]([]int{3}, []int{3}),
+				nil, nil, nil, nil,
+				ll[int, int]([]int{2}, []int{2}),
+				nil, nil,
+				ll[int, int]([]int{1}, []int{1}),
+				nil, nil, nil, nil, nil, nil, nil, // 16 empty buckets in total
+			}, Size: 5},
 			args[int]{5},
 			// Create a want to have HashMap with a Pairs array of size 8, because we are sizing down if len(Pairs) / 4 == HashMap.Size to len(Pairs) * 2
-			&HashMap[int, int]{[]*doublyLinkedListHM.LinkedList[int, int]{
-				ll[int, int]([]int{1, 2}, []int{1, 2}),
-				ll[int, int]([]int{3, 4}, []int{3, 4}),
-				nil, nil, nil, nil, nil, nil,
-			}, 4},
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
+				ll[int, int]([]int{3}, []int{3}),
+				nil, nil,
+				ll[int, int]([]int{4}, []int{4}),
+				ll[int, int]([]int{1}, []int{1}),
+				ll[int, int]([]int{2}, []int{2}),
+				nil, nil,
+			}, Size: 4},
 			intP(5), // we double the array size if it is full, so we need to get a len of 6 here.
 		},
 	}
-	testError := []testCase[chan int, chan int]{
+	testError := []testCase[func(), func()]{
 		{
-			// My hashing function does not support values of type channel
+			// My hashing function does not support non-nil values of type func
 			"test error",
-			&HashMap[chan int, chan int]{[]*doublyLinkedListHM.LinkedList[chan int, chan int]{
-				ll[chan int, chan int]([]chan int{}, []chan int{}),
-			}, 1},
-			args[chan int]{nil},
+			&HashMap[func(), func()]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[func(), func()]{
+				ll[func(), func()]([]func(){}, []func(){}),
+			}, Size: 1},
+			args[func()]{func() {}},
 			nil, // We do not care for it in this test.
 			nil, // We do not care for it in this test.
 		},
@@ -775,6 +866,7 @@ func TestHashMap_Remove(t *testing.T) {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
+			rh := robinHoodFrom(tt.hm)
 			gotVal, err := tt.hm.Remove(tt.args.key)
 			if err != nil {
 				t.Errorf("Hash function threw error: %v", err)
@@ -785,16 +877,496 @@ func TestHashMap_Remove(t *testing.T) {
 			if !mapsEqual(tt.hm, tt.wantHM) {
 				t.Errorf("Remove(), hm %v, wantHM %v", tt.hm, tt.wantHM)
 			}
+			if gotVal, _ := rh.Remove(tt.args.key); !reflect.DeepEqual(gotVal, tt.wantVal) {
+				t.Errorf("RobinHoodHashMap.Remove() gotVal = %v, want %v", gotVal, tt.wantVal)
+			}
+			if !robinHoodEqual(rh, tt.wantHM) {
+				t.Errorf("RobinHoodHashMap.Remove(), rh %v, wantHM %v", rh, tt.wantHM)
+			}
 		})
 	}
 	for _, tt := range testError {
 		println()
 		t.Run(tt.name, func(t *testing.T) {
 			defer sugar.Lite(t, tt.name)
+			rh := robinHoodFrom(tt.hm)
 			_, err := tt.hm.Remove(tt.args.key)
 			if err == nil {
 				t.Errorf("test %v should have thrown error. Got %v", tt.name, err)
 			}
+			if _, err := rh.Remove(tt.args.key); err == nil {
+				t.Errorf("test %v should have thrown error for RobinHoodHashMap. Got %v", tt.name, err)
+			}
+		})
+	}
+}
+
+func TestNewComparableHashMap(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+	a, b := &point{1, 2}, &point{1, 2}
+
+	t.Run("string keys", func(t *testing.T) {
+		defer sugar.Lite(t, "string keys")
+		hm := NewComparableHashMap[string, int](0)
+		for i, k := range []string{"a", "b", "c", "d", "e"} {
+			if err := hm.Insert(k, i); err != nil {
+				t.Errorf("Insert() threw error: %v", err)
+			}
+		}
+		if got, _ := hm.Get("c"); got == nil || *got != 2 {
+			t.Errorf("Get() got = %v, want %v", got, 2)
+		}
+		if got, _ := hm.Remove("a"); got == nil || *got != 0 {
+			t.Errorf("Remove() got = %v, want %v", got, 0)
+		}
+		if found, _ := hm.ContainsKey("a"); found {
+			t.Errorf("ContainsKey() found removed key")
+		}
+		if hm.Size != 4 {
+			t.Errorf("Size = %v, want %v", hm.Size, 4)
+		}
+	})
+	println()
+	t.Run("struct keys", func(t *testing.T) {
+		defer sugar.Lite(t, "struct keys")
+		hm := NewComparableHashMap[point, string](0)
+		hm.Insert(point{1, 2}, "a")
+		hm.Insert(point{2, 1}, "b")
+		if got, _ := hm.Get(point{2, 1}); got == nil || *got != "b" {
+			t.Errorf("Get() got = %v, want %v", got, "b")
+		}
+	})
+	println()
+	t.Run("pointer keys are compared with ==", func(t *testing.T) {
+		defer sugar.Lite(t, "pointer keys are compared with ==")
+		hm := NewComparableHashMap[*point, string](0)
+		hm.Insert(a, "a")
+		if found, _ := hm.ContainsKey(b); found {
+			t.Errorf("ContainsKey() found a different pointer to an equal struct")
+		}
+		if found, _ := hm.ContainsKey(a); !found {
+			t.Errorf("ContainsKey() did not find pointer key")
+		}
+	})
+	println()
+	t.Run("channel keys", func(t *testing.T) {
+		defer sugar.Lite(t, "channel keys")
+		// Channels are comparable and hashed by their memory without encoding them.
+		ch := make(chan int)
+		hm := NewComparableHashMap[chan int, int](1)
+		if err := hm.Insert(ch, 1); err != nil {
+			t.Errorf("Insert() threw error: %v", err)
+		}
+		if got, _ := hm.Get(ch); got == nil || *got != 1 {
+			t.Errorf("Get() got = %v, want %v", got, 1)
+		}
+	})
+	println()
+	t.Run("struct keys with pointers after the pointees changed", func(t *testing.T) {
+		defer sugar.Lite(t, "struct keys with pointers after the pointees changed")
+		type node struct {
+			Val int
+		}
+		type key struct {
+			Name string
+			P    *node
+		}
+		hm := NewComparableHashMap[key, int](1024)
+		keys := make([]key, 100)
+		for i := range keys {
+			keys[i] = key{strconv.Itoa(i), &node{i}}
+			hm.Insert(keys[i], i)
+		}
+		for i := range keys {
+			keys[i].P.Val = -i - 1
+		}
+		for i, k := range keys {
+			if got, _ := hm.Get(k); got == nil || *got != i {
+				t.Errorf("Get(%v) got = %v, want %v", k.Name, got, i)
+			}
+		}
+	})
+	println()
+	t.Run("key pointing to itself", func(t *testing.T) {
+		defer sugar.Lite(t, "key pointing to itself")
+		type cyclic struct {
+			Name string
+			Self *cyclic
+		}
+		c := &cyclic{Name: "c"}
+		c.Self = c
+		hm := NewComparableHashMap[cyclic, int](0)
+		if err := hm.Insert(*c, 1); err != nil {
+			t.Fatalf("Insert() threw error: %v", err)
+		}
+		if got, err := hm.Get(*c); err != nil || got == nil || *got != 1 {
+			t.Errorf("Get() got = %v, %v, want %v", got, err, 1)
+		}
+		var i any = c
+		hmAny := NewComparableHashMap[any, int](0)
+		if err := hmAny.Insert(i, 2); err != nil {
+			t.Fatalf("Insert() of an interface holding the pointer threw error: %v", err)
+		}
+		c.Name = "changed"
+		if got, _ := hmAny.Get(i); got == nil || *got != 2 {
+			t.Errorf("Get() of an interface holding the pointer got = %v, want %v", got, 2)
+		}
+	})
+}
+
+// filledHM creates a HashMap with testSeed and inserts all key value pairs.
+func filledHM(keys, vals []int) *HashMap[int, int] {
+	hm := NewHashMapWithSeed[int, int](0, testSeed)
+	for i := range keys {
+		hm.Insert(keys[i], vals[i])
+	}
+	return hm
+}
+
+func TestHashMap_InsertExistingKey(t *testing.T) {
+	type testCase struct {
+		name     string
+		hm       *HashMap[int, int]
+		key, val int
+		wantSize uint
+	}
+	tests := []testCase{
+		{"new key", filledHM([]int{1, 2}, []int{1, 2}), 3, 3, 3},
+		{"existing key", filledHM([]int{1, 2}, []int{1, 2}), 2, 5, 2},
+		{"existing key twice", filledHM([]int{1, 2, 2}, []int{1, 2, 3}), 2, 5, 2},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			tt.hm.Insert(tt.key, tt.val)
+			if got, _ := tt.hm.Get(tt.key); got == nil || *got != tt.val {
+				t.Errorf("Get() got = %v, want %v", got, tt.val)
+			}
+			if tt.hm.Size != tt.wantSize {
+				t.Errorf("Insert(), Size = %v, wantSize %v", tt.hm.Size, tt.wantSize)
+			}
+			tt.hm.Remove(tt.key)
+			if found, _ := tt.hm.ContainsKey(tt.key); found {
+				t.Errorf("Remove() did not remove all values of the key")
+			}
+		})
+	}
+}
+
+func TestHashMap_InsertIfAbsent(t *testing.T) {
+	type testCase struct {
+		name         string
+		hm           *HashMap[int, int]
+		key, val     int
+		wantInserted bool
+		wantVal      int
+		wantSize     uint
+	}
+	tests := []testCase{
+		{"into empty map", filledHM([]int{}, []int{}), 1, 1, true, 1, 1},
+		{"absent key", filledHM([]int{1, 2}, []int{1, 2}), 3, 3, true, 3, 3},
+		{"existing key", filledHM([]int{1, 2}, []int{1, 2}), 2, 5, false, 2, 2},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			gotInserted, err := tt.hm.InsertIfAbsent(tt.key, tt.val)
+			if err != nil {
+				t.Errorf("Hash function threw error: %v", err)
+			}
+			if gotInserted != tt.wantInserted {
+				t.Errorf("InsertIfAbsent() gotInserted = %v, want %v", gotInserted, tt.wantInserted)
+			}
+			if got, _ := tt.hm.Get(tt.key); got == nil || *got != tt.wantVal {
+				t.Errorf("Get() got = %v, want %v", got, tt.wantVal)
+			}
+			if tt.hm.Size != tt.wantSize {
+				t.Errorf("InsertIfAbsent(), Size = %v, wantSize %v", tt.hm.Size, tt.wantSize)
+			}
+		})
+	}
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		hm := NewHashMapWithSeed[func(), int](1, testSeed)
+		if _, err := hm.InsertIfAbsent(func() {}, 1); err == nil {
+			t.Errorf("test error should have thrown error. Got %v", err)
+		}
+	})
+}
+
+func TestHashMap_GetOrInsert(t *testing.T) {
+	type testCase struct {
+		name       string
+		hm         *HashMap[int, int]
+		key, val   int
+		wantVal    int
+		wantLoaded bool
+		wantSize   uint
+	}
+	tests := []testCase{
+		{"into empty map", filledHM([]int{}, []int{}), 1, 1, 1, false, 1},
+		{"absent key", filledHM([]int{1, 2}, []int{1, 2}), 3, 3, 3, false, 3},
+		{"existing key", filledHM([]int{1, 2}, []int{1, 2}), 2, 5, 2, true, 2},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			gotVal, gotLoaded, err := tt.hm.GetOrInsert(tt.key, tt.val)
+			if err != nil {
+				t.Errorf("Hash function threw error: %v", err)
+			}
+			if gotVal == nil || *gotVal != tt.wantVal {
+				t.Errorf("GetOrInsert() gotVal = %v, want %v", gotVal, tt.wantVal)
+			}
+			if gotLoaded != tt.wantLoaded {
+				t.Errorf("GetOrInsert() gotLoaded = %v, want %v", gotLoaded, tt.wantLoaded)
+			}
+			// The returned pointer points into the map.
+			*gotVal = 42
+			if got, _ := tt.hm.Get(tt.key); got == nil || *got != 42 {
+				t.Errorf("Get() got = %v, want %v", got, 42)
+			}
+			if tt.hm.Size != tt.wantSize {
+				t.Errorf("GetOrInsert(), Size = %v, wantSize %v", tt.hm.Size, tt.wantSize)
+			}
+		})
+	}
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		hm := NewHashMapWithSeed[func(), int](1, testSeed)
+		if _, _, err := hm.GetOrInsert(func() {}, 1); err == nil {
+			t.Errorf("test error should have thrown error. Got %v", err)
+		}
+	})
+}
+
+func TestHashMap_GetOrInsertFunc(t *testing.T) {
+	type testCase struct {
+		name       string
+		hm         *HashMap[int, int]
+		key        int
+		wantVal    int
+		wantLoaded bool
+		wantCalls  int
+	}
+	tests := []testCase{
+		{"into empty map", filledHM([]int{}, []int{}), 1, 5, false, 1},
+		{"absent key", filledHM([]int{1, 2}, []int{1, 2}), 3, 5, false, 1},
+		{"existing key does not call newVal", filledHM([]int{1, 2}, []int{1, 2}), 2, 2, true, 0},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			calls := 0
+			gotVal, gotLoaded, err := tt.hm.GetOrInsertFunc(tt.key, func() int { calls++; return 5 })
+			if err != nil {
+				t.Errorf("Hash function threw error: %v", err)
+			}
+			if gotVal == nil || *gotVal != tt.wantVal || gotLoaded != tt.wantLoaded {
+				t.Errorf("GetOrInsertFunc() = %v, %v, want %v, %v", gotVal, gotLoaded, tt.wantVal, tt.wantLoaded)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("GetOrInsertFunc() called newVal %v times, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		hm := NewHashMapWithSeed[func(), int](1, testSeed)
+		if _, _, err := hm.GetOrInsertFunc(func() {}, func() int { return 1 }); err == nil {
+			t.Errorf("test error should have thrown error. Got %v", err)
+		}
+	})
+}
+
+func TestHashMap_Update(t *testing.T) {
+	type testCase struct {
+		name     string
+		hm       *HashMap[int, int]
+		key      int
+		wantOld  int
+		wantOk   bool
+		wantSize uint
+	}
+	tests := []testCase{
+		{"into empty map", filledHM([]int{}, []int{}), 1, 0, false, 1},
+		{"absent key", filledHM([]int{1, 2}, []int{1, 2}), 3, 0, false, 3},
+		{"existing key", filledHM([]int{1, 2}, []int{1, 2}), 2, 2, true, 2},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			calls := 0
+			err := tt.hm.Update(tt.key, func(old int, ok bool) int {
+				calls++
+				if old != tt.wantOld || ok != tt.wantOk {
+					t.Errorf("Update() fn got old = %v, ok = %v, want %v, %v", old, ok, tt.wantOld, tt.wantOk)
+				}
+				return old + 10
+			})
+			if err != nil {
+				t.Errorf("Hash function threw error: %v", err)
+			}
+			if calls != 1 {
+				t.Errorf("Update() called fn %v times, want 1", calls)
+			}
+			if got, _ := tt.hm.Get(tt.key); got == nil || *got != tt.wantOld+10 {
+				t.Errorf("Get() got = %v, want %v", got, tt.wantOld+10)
+			}
+			if tt.hm.Size != tt.wantSize {
+				t.Errorf("Update(), Size = %v, wantSize %v", tt.hm.Size, tt.wantSize)
+			}
+		})
+	}
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		hm := NewHashMapWithSeed[func(), int](1, testSeed)
+		if err := hm.Update(func() {}, func(old int, ok bool) int { return 1 }); err == nil {
+			t.Errorf("test error should have thrown error. Got %v", err)
+		}
+	})
+}
+
+// nonEmptyBuckets counts the buckets which still hold pairs.
+func nonEmptyBuckets(buckets []*doublyLinkedListHM.LinkedList[int, int]) int {
+	count := 0
+	for _, b := range buckets {
+		if b != nil {
+			count++
+		}
+	}
+	return count
+}
+
+func TestHashMap_IncrementalRehash(t *testing.T) {
+	println()
+	t.Run("grow", func(t *testing.T) {
+		defer sugar.Lite(t, "grow")
+		hm := NewHashMapWithSeed[int, int](0, testSeed)
+		n := 1024
+		rehashes := 0
+		for i := range n {
+			oldBuckets := nonEmptyBuckets(hm.oldPairs)
+			wasRehashing := hm.oldPairs != nil
+			hm.Insert(i, i)
+			if wasRehashing && hm.oldPairs != nil {
+				if moved := oldBuckets - nonEmptyBuckets(hm.oldPairs); moved > rehashBucketsPerStep {
+					t.Fatalf("Insert() moved %v buckets, want at most %v", moved, rehashBucketsPerStep)
+				}
+			}
+			if !wasRehashing && hm.oldPairs != nil {
+				rehashes++
+			}
+			// Every pair must be found, no matter if it is still in the old buckets.
+			if got, _ := hm.Get(i / 2); got == nil || *got != i/2 {
+				t.Fatalf("Get(%v) got = %v, want %v", i/2, got, i/2)
+			}
+		}
+		if rehashes == 0 {
+			t.Errorf("Insert() never rehashed incrementally")
+		}
+		if hm.Size != uint(n) || len(hm.Keys()) != n || len(hm.Values()) != n {
+			t.Errorf("Size = %v, Keys = %v, Values = %v, want %v", hm.Size, len(hm.Keys()), len(hm.Values()), n)
+		}
+	})
+	println()
+	t.Run("operations while rehashing", func(t *testing.T) {
+		defer sugar.Lite(t, "operations while rehashing")
+		hm := NewHashMapWithSeed[int, int](0, testSeed)
+		// 256 pairs fill 256 buckets, so the next insert starts a resize.
+		for i := range 257 {
+			hm.Insert(i, i)
+		}
+		if hm.oldPairs == nil {
+			t.Fatalf("HashMap is not rehashing")
+		}
+		// Find a key which was not moved yet.
+		oldKey := -1
+		for _, b := range hm.oldPairs[len(hm.oldPairs)-10:] {
+			if b != nil {
+				oldKey = b.Head.Key
+				break
+			}
+		}
+		if oldKey < 0 {
+			t.Fatalf("no pair left in the old buckets")
+		}
+		if found, _ := hm.ContainsKey(oldKey); !found {
+			t.Errorf("ContainsKey(%v) did not find key in old buckets", oldKey)
+		}
+		if key := hm.GetKey(oldKey); key == nil || *key != oldKey {
+			t.Errorf("GetKey(%v) got = %v", oldKey, key)
+		}
+		hm.Insert(oldKey, -1)
+		if got, _ := hm.Get(oldKey); got == nil || *got != -1 {
+			t.Errorf("Get(%v) got = %v, want %v", oldKey, got, -1)
+		}
+		if hm.Size != 257 {
+			t.Errorf("Insert() of existing key in old buckets changed Size to %v", hm.Size)
+		}
+		if got, _ := hm.Remove(oldKey); got == nil || *got != -1 {
+			t.Errorf("Remove(%v) got = %v, want %v", oldKey, got, -1)
+		}
+		if found, _ := hm.ContainsKey(oldKey); found {
+			t.Errorf("ContainsKey(%v) found removed key", oldKey)
+		}
+		hm.Clear()
+		if hm.oldPairs != nil || hm.Size != 0 {
+			t.Errorf("Clear() did not stop rehashing")
+		}
+	})
+	println()
+	t.Run("shrink", func(t *testing.T) {
+		defer sugar.Lite(t, "shrink")
+		hm := NewHashMapWithSeed[int, int](0, testSeed)
+		n := 1024
+		for i := range n {
+			hm.Insert(i, i)
+		}
+		for i := range n - 1 {
+			if got, _ := hm.Remove(i); got == nil || *got != i {
+				t.Fatalf("Remove(%v) got = %v, want %v", i, got, i)
+			}
+		}
+		if got, _ := hm.Get(n - 1); got == nil || *got != n-1 {
+			t.Errorf("Get(%v) got = %v, want %v", n-1, got, n-1)
+		}
+		if hm.Size != 1 || len(hm.Pairs) > 4 {
+			t.Errorf("Size = %v, buckets = %v, want 1 pair in a shrunk map", hm.Size, len(hm.Pairs))
+		}
+	})
+}
+
+func TestBucketIndex(t *testing.T) {
+	type testCase struct {
+		name string
+		h    uint32
+		n    int
+		want int
+	}
+	tests := []testCase{
+		{"small hash", 7, 5, 2},
+		{"hash with highest bit set", math.MaxUint32, 10, 5},
+		{"single bucket", 123456789, 1, 0},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			if got := bucketIndex(tt.h, tt.n); got != tt.want {
+				t.Errorf("bucketIndex() = %v, want %v", got, tt.want)
+			}
 		})
 	}
 }
@@ -0,0 +1,95 @@
+package hashMap
+
+import (
+	"crypto/rand"
+	"dsa/algorithms/hash"
+	"encoding/binary"
+	"reflect"
+)
+
+// Hasher calculates the hashes of keys and decides if two keys are equal.
+//
+// Two keys which are Equal must always have the same Hash, otherwise the HashMap can not find them again.
+type Hasher[K any] interface {
+	Hash(key K, seed uint32) (uint32, error)
+	Equal(a, b K) bool
+}
+
+// Murmur3Hasher is the default Hasher of a HashMap. It works for (almost) all types by encoding the keys canonically.
+// Keys can implement hash.Hashable to supply their own bytes. Non-nil funcs are not supported.
+type Murmur3Hasher[K any] struct{}
+
+func (Murmur3Hasher[K]) Hash(key K, seed uint32) (uint32, error) {
+	return hash.Murmur3(key, seed)
+}
+
+func (Murmur3Hasher[K]) Equal(a, b K) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+// ComparableHasher hashes comparable keys from their memory representation and compares them with ==.
+// Pointers, also inside structs and interfaces, are hashed by their address like == compares them.
+type ComparableHasher[K comparable] struct{}
+
+func (ComparableHasher[K]) Hash(key K, seed uint32) (uint32, error) {
+	return hash.Murmur3Fast(key, seed)
+}
+
+func (ComparableHasher[K]) Equal(a, b K) bool {
+	return a == b
+}
+
+// DJB2Hasher uses hash.DJB2, which has no seed. The seed is ignored.
+type DJB2Hasher[K any] struct{}
+
+func (DJB2Hasher[K]) Hash(key K, _ uint32) (uint32, error) {
+	return hash.DJB2(key)
+}
+
+func (DJB2Hasher[K]) Equal(a, b K) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+// XxHashHasher uses hash.XxHash, which has no seed. The seed is ignored.
+//
+// The 64-bit hash is folded to 32 bits, so that the upper bits still influence the bucket index.
+type XxHashHasher[K any] struct{}
+
+func (XxHashHasher[K]) Hash(key K, _ uint32) (uint32, error) {
+	h, err := hash.XxHash(key)
+	return uint32(h) ^ uint32(h>>32), err
+}
+
+func (XxHashHasher[K]) Equal(a, b K) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+// SipHasher uses hash.SipHash with a secret 128-bit key, so that attacker-controlled keys can not be chosen to collide.
+// Create it with NewSipHasher, the zero value has an all-zero key.
+//
+// The seed of the HashMap is mixed into the key, so HashMaps sharing one SipHasher still hash differently.
+// The 64-bit hash is folded to 32 bits like in XxHashHasher.
+type SipHasher[K any] struct {
+	key [16]byte
+}
+
+// NewSipHasher creates a SipHasher with a key drawn from crypto/rand. Runtime O(1)
+func NewSipHasher[K any]() SipHasher[K] {
+	var h SipHasher[K]
+	// Same as randomSeed: without randomness, the key would be predictable and the protection would be gone.
+	if _, err := rand.Read(h.key[:]); err != nil {
+		panic("hashMap: could not draw a random SipHash key: " + err.Error())
+	}
+	return h
+}
+
+func (h SipHasher[K]) Hash(key K, seed uint32) (uint32, error) {
+	k := h.key
+	binary.LittleEndian.PutUint32(k[:4], binary.LittleEndian.Uint32(k[:4])^seed)
+	s, err := hash.SipHash(key, k)
+	return uint32(s) ^ uint32(s>>32), err
+}
+
+func (SipHasher[K]) Equal(a, b K) bool {
+	return reflect.DeepEqual(a, b)
+}
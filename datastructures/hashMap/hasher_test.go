@@ -0,0 +1,102 @@
+package hashMap
+
+import (
+	"dsa/util/sugar"
+	"strings"
+	"testing"
+)
+
+// caseInsensitiveHasher treats string keys as equal, regardless of their case.
+type caseInsensitiveHasher struct{}
+
+func (caseInsensitiveHasher) Hash(key string, seed uint32) (uint32, error) {
+	return ComparableHasher[string]{}.Hash(strings.ToLower(key), seed)
+}
+
+func (caseInsensitiveHasher) Equal(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+func TestWithHasher(t *testing.T) {
+	type testCase struct {
+		name   string
+		hasher Hasher[string]
+	}
+	tests := []testCase{
+		{"Murmur3Hasher", Murmur3Hasher[string]{}},
+		{"ComparableHasher", ComparableHasher[string]{}},
+		{"DJB2Hasher", DJB2Hasher[string]{}},
+		{"XxHashHasher", XxHashHasher[string]{}},
+		{"SipHasher", NewSipHasher[string]()},
+	}
+	keys := []string{"alpha", "beta", "gamma", "delta", "epsilon", "zeta", "eta", "theta"}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			hm := NewHashMap[string, int](0, WithHasher(tt.hasher))
+			for i, k := range keys {
+				if err := hm.Insert(k, i); err != nil {
+					t.Errorf("Insert() threw error: %v", err)
+				}
+			}
+			for i, k := range keys {
+				if got, _ := hm.Get(k); got == nil || *got != i {
+					t.Errorf("Get(%v) got = %v, want %v", k, got, i)
+				}
+			}
+			if got, _ := hm.Remove("gamma"); got == nil || *got != 2 {
+				t.Errorf("Remove() got = %v, want %v", got, 2)
+			}
+			if found, _ := hm.ContainsKey("gamma"); found {
+				t.Errorf("ContainsKey() found removed key")
+			}
+		})
+	}
+	println()
+	t.Run("custom equality", func(t *testing.T) {
+		defer sugar.Lite(t, "custom equality")
+		hm := NewHashMap[string, int](0, WithHasher[string](caseInsensitiveHasher{}))
+		hm.Insert("Hello", 1)
+		if got, _ := hm.Get("HELLO"); got == nil || *got != 1 {
+			t.Errorf("Get() got = %v, want %v", got, 1)
+		}
+		if _, ok := hm.Hasher().(caseInsensitiveHasher); !ok {
+			t.Errorf("Hasher() = %T, want caseInsensitiveHasher", hm.Hasher())
+		}
+	})
+	println()
+	t.Run("default hasher", func(t *testing.T) {
+		defer sugar.Lite(t, "default hasher")
+		hm := NewHashMap[string, int](0)
+		if _, ok := hm.Hasher().(Murmur3Hasher[string]); !ok {
+			t.Errorf("Hasher() = %T, want Murmur3Hasher", hm.Hasher())
+		}
+	})
+}
+
+func TestSipHasher(t *testing.T) {
+	name := "secret key and seed"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		a, b := NewSipHasher[string](), NewSipHasher[string]()
+		if a == b {
+			t.Fatalf("NewSipHasher() drew the same key twice")
+		}
+		ha, _ := a.Hash("key", testSeed)
+		hb, _ := b.Hash("key", testSeed)
+		if ha == hb {
+			t.Errorf("Hash() with different keys gave the same hash %#x", ha)
+		}
+		if again, _ := a.Hash("key", testSeed); again != ha {
+			t.Errorf("Hash() is not deterministic, got %#x and %#x", ha, again)
+		}
+		if other, _ := a.Hash("key", testSeed+1); other == ha {
+			t.Errorf("Hash() ignored the seed")
+		}
+		if _, err := NewSipHasher[func()]().Hash(func() {}, testSeed); err == nil {
+			t.Errorf("Hash() of a func did not throw an error")
+		}
+	})
+}
@@ -0,0 +1,73 @@
+package hashMap
+
+import (
+	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+	"iter"
+)
+
+// All returns an iterator over all key value pairs. Runtime O(n)
+//
+// The pairs are streamed directly from the buckets without allocating a slice. The order is the bucket order.
+// The HashMap must not be modified while iterating.
+func (hm *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		// While rehashing, the pairs are spread over the new and the old buckets.
+		if yieldBuckets(hm.Pairs, yield) {
+			yieldBuckets(hm.oldPairs, yield)
+		}
+	}
+}
+
+// yieldBuckets yields every pair of buckets in bucket order.
+//
+// Returns false if yield stopped the iteration.
+func yieldBuckets[K, V any](buckets []*doublyLinkedListHM.LinkedList[K, V], yield func(K, V) bool) bool {
+	for _, p := range buckets {
+		if p == nil {
+			continue
+		}
+		for node := p.Head; node != nil; node = node.Next {
+			if !yield(node.Key, node.Value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// KeysSeq returns an iterator over all keys. Runtime O(n)
+func (hm *HashMap[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range hm.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns an iterator over all values. Runtime O(n)
+func (hm *HashMap[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range hm.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect creates a new HashMap with a random seed from all key value pairs of seq. Runtime O(n)
+//
+// Later pairs replace the values of earlier pairs with the same key.
+//
+// Returns the HashMap collected so far and the error if a key could not be hashed.
+func Collect[K, V any](seq iter.Seq2[K, V], opts ...Option[K]) (*HashMap[K, V], error) {
+	hm := NewHashMap[K, V](0, opts...)
+	for k, v := range seq {
+		if err := hm.Insert(k, v); err != nil {
+			return hm, err
+		}
+	}
+	return hm, nil
+}
@@ -0,0 +1,147 @@
+package hashMap
+
+import (
+	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+	"dsa/util/sugar"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestHashMap_All(t *testing.T) {
+	type testCase struct {
+		name string
+		hm   *HashMap[int, int]
+		want map[int]int
+	}
+	tests := []testCase{
+		{"empty map", filledHM([]int{}, []int{}), map[int]int{}},
+		{"filled map", filledHM([]int{1, 2, 3}, []int{4, 5, 6}), map[int]int{1: 4, 2: 5, 3: 6}},
+		{
+			"map with empty buckets",
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
+				nil,
+				ll[int, int]([]int{1, 2}, []int{4, 5}),
+				nil,
+				ll[int, int]([]int{3}, []int{6}),
+			}, Size: 3},
+			map[int]int{1: 4, 2: 5, 3: 6},
+		},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			if got := maps.Collect(tt.hm.All()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("All() = %v, want %v", got, tt.want)
+			}
+			if got := slices.Collect(tt.hm.KeysSeq()); !slicesEqualUnordered(got, slices.Collect(maps.Keys(tt.want))) {
+				t.Errorf("KeysSeq() = %v, want %v", got, slices.Collect(maps.Keys(tt.want)))
+			}
+			if got := slices.Collect(tt.hm.ValuesSeq()); !slicesEqualUnordered(got, slices.Collect(maps.Values(tt.want))) {
+				t.Errorf("ValuesSeq() = %v, want %v", got, slices.Collect(maps.Values(tt.want)))
+			}
+		})
+	}
+}
+
+func TestHashMap_AllAllocs(t *testing.T) {
+	type testCase struct {
+		name string
+		hm   *HashMap[int, int]
+	}
+	tests := []testCase{
+		{"filled map", rangeHM(100)},
+		{"while rehashing", rehashingHM(100)},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			sum := 0
+			allocs := testing.AllocsPerRun(100, func() {
+				for k, v := range tt.hm.All() {
+					sum += k + v
+				}
+			})
+			if allocs != 0 {
+				t.Errorf("All() allocated %v times per iteration, want 0", allocs)
+			}
+		})
+	}
+}
+
+func TestHashMap_AllBreak(t *testing.T) {
+	hm := filledHM([]int{1, 2, 3, 4, 5}, []int{1, 2, 3, 4, 5})
+	type testCase struct {
+		name string
+		seq  func(yield func() bool)
+	}
+	tests := []testCase{
+		{"All", func(yield func() bool) {
+			for range hm.All() {
+				if !yield() {
+					break
+				}
+			}
+		}},
+		{"KeysSeq", func(yield func() bool) {
+			for range hm.KeysSeq() {
+				if !yield() {
+					break
+				}
+			}
+		}},
+		{"ValuesSeq", func(yield func() bool) {
+			for range hm.ValuesSeq() {
+				if !yield() {
+					break
+				}
+			}
+		}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			// A range-over-func loop panics if the iterator keeps yielding after the loop body broke out.
+			count := 0
+			tt.seq(func() bool {
+				count++
+				return count < 2
+			})
+			if count != 2 {
+				t.Errorf("%v visited %v pairs, want 2", tt.name, count)
+			}
+		})
+	}
+}
+
+func TestCollect(t *testing.T) {
+	println()
+	t.Run("collect built-in map", func(t *testing.T) {
+		defer sugar.Lite(t, "collect built-in map")
+		want := map[string]int{"a": 1, "b": 2, "c": 3}
+		hm, err := Collect(maps.All(want), WithHasher[string](ComparableHasher[string]{}))
+		if err != nil {
+			t.Errorf("Collect() threw error: %v", err)
+		}
+		if got := maps.Collect(hm.All()); !reflect.DeepEqual(got, want) {
+			t.Errorf("Collect() = %v, want %v", got, want)
+		}
+		if _, ok := hm.Hasher().(ComparableHasher[string]); !ok {
+			t.Errorf("Collect() did not apply options, Hasher() = %T", hm.Hasher())
+		}
+	})
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		seq := func(yield func(func(), int) bool) {
+			yield(func() {}, 1)
+		}
+		if _, err := Collect(seq); err == nil {
+			t.Errorf("test error should have thrown error. Got %v", err)
+		}
+	})
+}
@@ -0,0 +1,221 @@
+package hashMap
+
+import (
+	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+	"iter"
+)
+
+/*
+HashMap which remembers the order of its pairs (like Java's LinkedHashMap).
+
+The order of HashMap.Keys is the bucket order, which changes with every resize. The LinkedHashMap additionally threads
+every pair onto a doubly linked list (the nodes of doublyLinkedListHM) with a head and a tail.
+A HashMap from key to node finds the node of a key in O(1), and the Prev and Next pointers of the node unlink it in O(1).
+
+Insertion order (default): Pairs are iterated in the order their keys were inserted first.
+Replacing the value of an existing key does not change its position.
+
+Access order: Every Get and Insert of a key moves it to the end, so the least recently used key comes first.
+This is the order an LRU cache evicts in.
+*/
+
+type LinkedHashMap[K, V any] struct {
+	index *HashMap[K, *doublyLinkedListHM.Node[K, V]]
+	// head is the oldest pair, tail the newest.
+	head        *doublyLinkedListHM.Node[K, V]
+	tail        *doublyLinkedListHM.Node[K, V]
+	accessOrder bool
+}
+
+// NewLinkedHashMap creates a new LinkedHashMap in insertion order with a random seed. Runtime O(n)
+//
+// initialCapacity - The initial capacity of the LinkedHashMap on its creation.
+//
+// opts - Optional configuration, e.g. WithHasher to replace the default Murmur3Hasher.
+func NewLinkedHashMap[K, V any](initialCapacity uint, opts ...Option[K]) *LinkedHashMap[K, V] {
+	return &LinkedHashMap[K, V]{index: NewHashMap[K, *doublyLinkedListHM.Node[K, V]](initialCapacity, opts...)}
+}
+
+// NewAccessOrderLinkedHashMap creates a new LinkedHashMap in access order with a random seed. Runtime O(n)
+//
+// Get and Insert move a key to the end, so iterating starts with the least recently used key.
+func NewAccessOrderLinkedHashMap[K, V any](initialCapacity uint, opts ...Option[K]) *LinkedHashMap[K, V] {
+	lhm := NewLinkedHashMap[K, V](initialCapacity, opts...)
+	lhm.accessOrder = true
+	return lhm
+}
+
+// Get value by key. Runtime O(1)
+//
+// In access order, key is moved to the end.
+//
+// Returns nil if no value was found
+func (lhm *LinkedHashMap[K, V]) Get(key K) (val *V, err error) {
+	node, err := lhm.index.Get(key)
+	if err != nil || node == nil {
+		return val, err
+	}
+
+	if lhm.accessOrder {
+		lhm.moveToBack(*node)
+	}
+	return &(*node).Value, nil
+}
+
+// Insert a key value pair or replace the value if key already exists. Runtime average case O(1), worst case O(n) when upsizing.
+//
+// A new key is appended at the end. An existing key keeps its position in insertion order and is moved to the end in access order.
+func (lhm *LinkedHashMap[K, V]) Insert(key K, val V) error {
+	lhm.index.rehashStep()
+	// Look the key up first, so that replacing a value reuses its node instead of allocating a new one.
+	h, _, entry, err := lhm.index.lookup(key)
+	if err != nil {
+		return err
+	}
+
+	if entry != nil {
+		entry.Value.Value = val
+		if lhm.accessOrder {
+			lhm.moveToBack(entry.Value)
+		}
+		return nil
+	}
+
+	node := &doublyLinkedListHM.Node[K, V]{Key: key, Value: val}
+	if _, err := lhm.index.insertNew(key, node, h); err != nil {
+		return err
+	}
+	lhm.pushBack(node)
+	return nil
+}
+
+// Remove key value pair by key. Runtime average case: O(1), worst case O(n) when downsizing.
+//
+// Returns the value or nil if no value was found.
+func (lhm *LinkedHashMap[K, V]) Remove(key K) (val *V, err error) {
+	node, err := lhm.index.Remove(key)
+	if err != nil || node == nil {
+		return val, err
+	}
+
+	lhm.unlink(*node)
+	return &(*node).Value, nil
+}
+
+// ContainsKey - Check if key exists. Runtime O(1)
+//
+// Unlike Get, it does not change the access order.
+func (lhm *LinkedHashMap[K, V]) ContainsKey(key K) (bool, error) {
+	return lhm.index.ContainsKey(key)
+}
+
+// Size returns the number of key value pairs. Runtime O(1)
+func (lhm *LinkedHashMap[K, V]) Size() uint {
+	return lhm.index.Size
+}
+
+// IsEmpty - Check if map is emtpy. Runtime O(1)
+func (lhm *LinkedHashMap[K, V]) IsEmpty() bool {
+	return lhm.index.IsEmpty()
+}
+
+// All returns an iterator over all key value pairs from the oldest to the newest. Runtime O(n)
+//
+// The LinkedHashMap must not be modified while iterating.
+func (lhm *LinkedHashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := lhm.head; node != nil; node = node.Next {
+			if !yield(node.Key, node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over all key value pairs from the newest to the oldest. Runtime O(n)
+func (lhm *LinkedHashMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := lhm.tail; node != nil; node = node.Prev {
+			if !yield(node.Key, node.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an array of all keys from the oldest to the newest. Runtime O(n)
+func (lhm *LinkedHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, lhm.Size())
+	for k := range lhm.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns an array of all values from the oldest to the newest. Runtime O(n)
+func (lhm *LinkedHashMap[K, V]) Values() []V {
+	values := make([]V, 0, lhm.Size())
+	for _, v := range lhm.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Oldest returns the first pair of the order without changing it. Runtime O(1)
+//
+// In access order, this is the least recently used pair. found is false if the LinkedHashMap is empty.
+func (lhm *LinkedHashMap[K, V]) Oldest() (key K, val V, found bool) {
+	if lhm.head == nil {
+		return key, val, false
+	}
+	return lhm.head.Key, lhm.head.Value, true
+}
+
+// Clear LinkedHashMap, resetting it to a newly initialized state. Runtime O(1)
+func (lhm *LinkedHashMap[K, V]) Clear() {
+	lhm.index.Clear()
+	lhm.head = nil
+	lhm.tail = nil
+}
+
+// Hasher returns the Hasher used by the LinkedHashMap.
+func (lhm *LinkedHashMap[K, V]) Hasher() Hasher[K] {
+	return lhm.index.Hasher()
+}
+
+// pushBack appends node as the new tail.
+func (lhm *LinkedHashMap[K, V]) pushBack(node *doublyLinkedListHM.Node[K, V]) {
+	node.Prev = lhm.tail
+	node.Next = nil
+	if lhm.tail != nil {
+		lhm.tail.Next = node
+	} else {
+		lhm.head = node
+	}
+	lhm.tail = node
+}
+
+// unlink removes node from the order in O(1) using its Prev and Next pointers.
+func (lhm *LinkedHashMap[K, V]) unlink(node *doublyLinkedListHM.Node[K, V]) {
+	if node.Prev != nil {
+		node.Prev.Next = node.Next
+	} else {
+		lhm.head = node.Next
+	}
+	if node.Next != nil {
+		node.Next.Prev = node.Prev
+	} else {
+		lhm.tail = node.Prev
+	}
+	node.Prev = nil
+	node.Next = nil
+}
+
+// moveToBack moves node to the end of the order.
+func (lhm *LinkedHashMap[K, V]) moveToBack(node *doublyLinkedListHM.Node[K, V]) {
+	if lhm.tail == node {
+		return
+	}
+	lhm.unlink(node)
+	lhm.pushBack(node)
+}
@@ -0,0 +1,190 @@
+package hashMap
+
+import (
+	"dsa/util/sugar"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestLinkedHashMap_Order(t *testing.T) {
+	type testCase struct {
+		name        string
+		accessOrder bool
+		ops         func(lhm *LinkedHashMap[int, int])
+		wantKeys    []int
+		wantValues  []int
+	}
+	tests := []testCase{
+		{"empty map", false, func(lhm *LinkedHashMap[int, int]) {}, []int{}, []int{}},
+		{"insertion order", false, func(lhm *LinkedHashMap[int, int]) {
+			for _, k := range []int{5, 3, 9, 1} {
+				_ = lhm.Insert(k, k*10)
+			}
+		}, []int{5, 3, 9, 1}, []int{50, 30, 90, 10}},
+		{"replacing keeps position in insertion order", false, func(lhm *LinkedHashMap[int, int]) {
+			_ = lhm.Insert(1, 1)
+			_ = lhm.Insert(2, 2)
+			_ = lhm.Insert(1, 100)
+			_, _ = lhm.Get(1)
+		}, []int{1, 2}, []int{100, 2}},
+		{"remove head, middle and tail", false, func(lhm *LinkedHashMap[int, int]) {
+			for k := range 5 {
+				_ = lhm.Insert(k, k)
+			}
+			_, _ = lhm.Remove(0)
+			_, _ = lhm.Remove(2)
+			_, _ = lhm.Remove(4)
+		}, []int{1, 3}, []int{1, 3}},
+		{"reinsert after remove appends", false, func(lhm *LinkedHashMap[int, int]) {
+			_ = lhm.Insert(1, 1)
+			_ = lhm.Insert(2, 2)
+			_, _ = lhm.Remove(1)
+			_ = lhm.Insert(1, 1)
+		}, []int{2, 1}, []int{2, 1}},
+		{"access order moves on Get and Insert", true, func(lhm *LinkedHashMap[int, int]) {
+			for k := range 4 {
+				_ = lhm.Insert(k, k)
+			}
+			_, _ = lhm.Get(1)
+			_ = lhm.Insert(0, 10)
+			_, _ = lhm.ContainsKey(2)
+		}, []int{2, 3, 1, 0}, []int{2, 3, 1, 10}},
+		{"access order Get of tail", true, func(lhm *LinkedHashMap[int, int]) {
+			_ = lhm.Insert(1, 1)
+			_ = lhm.Insert(2, 2)
+			_, _ = lhm.Get(2)
+		}, []int{1, 2}, []int{1, 2}},
+		{"clear", false, func(lhm *LinkedHashMap[int, int]) {
+			_ = lhm.Insert(1, 1)
+			lhm.Clear()
+			_ = lhm.Insert(2, 2)
+		}, []int{2}, []int{2}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			lhm := NewLinkedHashMap[int, int](1)
+			if tt.accessOrder {
+				lhm = NewAccessOrderLinkedHashMap[int, int](1)
+			}
+			tt.ops(lhm)
+			if got := lhm.Keys(); !reflect.DeepEqual(got, tt.wantKeys) {
+				t.Errorf("Keys() = %v, want %v", got, tt.wantKeys)
+			}
+			if got := lhm.Values(); !reflect.DeepEqual(got, tt.wantValues) {
+				t.Errorf("Values() = %v, want %v", got, tt.wantValues)
+			}
+			backward := make([]int, 0)
+			for k := range lhm.Backward() {
+				backward = append(backward, k)
+			}
+			slices.Reverse(backward)
+			if !reflect.DeepEqual(backward, tt.wantKeys) {
+				t.Errorf("Backward() = %v, want reversed %v", backward, tt.wantKeys)
+			}
+			if lhm.Size() != uint(len(tt.wantKeys)) {
+				t.Errorf("Size() = %v, want %v", lhm.Size(), len(tt.wantKeys))
+			}
+		})
+	}
+}
+
+func TestLinkedHashMap_OrderSurvivesResize(t *testing.T) {
+	name := "order survives resize"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		lhm := NewLinkedHashMap[int, string](1)
+		want := make([]int, 0)
+		for i := 1000; i > 0; i -= 7 {
+			if err := lhm.Insert(i, "v"); err != nil {
+				t.Fatalf("Insert() error = %v", err)
+			}
+			want = append(want, i)
+		}
+		kept := make([]int, 0)
+		for i, k := range want {
+			if i%3 == 0 {
+				_, _ = lhm.Remove(k)
+			} else {
+				kept = append(kept, k)
+			}
+		}
+		want = kept
+
+		if got := lhm.Keys(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Keys() = %v, want %v", got, want)
+		}
+		for _, k := range want {
+			if v, err := lhm.Get(k); err != nil || v == nil || *v != "v" {
+				t.Errorf("Get(%v) = %v, %v", k, v, err)
+			}
+		}
+	})
+}
+
+func TestLinkedHashMap_Oldest(t *testing.T) {
+	type testCase struct {
+		name      string
+		keys      []int
+		getKey    int
+		wantKey   int
+		wantFound bool
+	}
+	tests := []testCase{
+		{"empty map", []int{}, 0, 0, false},
+		{"least recently used", []int{1, 2, 3}, 1, 2, true},
+		{"get of missing key changes nothing", []int{1, 2, 3}, 4, 1, true},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			lhm := NewAccessOrderLinkedHashMap[int, int](0)
+			for _, k := range tt.keys {
+				_ = lhm.Insert(k, k)
+			}
+			_, _ = lhm.Get(tt.getKey)
+			key, _, found := lhm.Oldest()
+			if key != tt.wantKey || found != tt.wantFound {
+				t.Errorf("Oldest() = %v, %v, want %v, %v", key, found, tt.wantKey, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestLinkedHashMap_InsertExistingKey(t *testing.T) {
+	type testCase struct {
+		name string
+		lhm  *LinkedHashMap[int, int]
+		want []int
+	}
+	tests := []testCase{
+		{"insertion order", NewLinkedHashMap[int, int](0, WithHasher[int](ComparableHasher[int]{})), []int{0, 1, 2, 3, 4}},
+		{"access order", NewAccessOrderLinkedHashMap[int, int](0, WithHasher[int](ComparableHasher[int]{})), []int{0, 1, 3, 4, 2}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			for i := range 5 {
+				_ = tt.lhm.Insert(i, i)
+			}
+			// Replacing a value reuses the node of the key.
+			allocs := testing.AllocsPerRun(100, func() {
+				_ = tt.lhm.Insert(2, 20)
+			})
+			if allocs != 0 {
+				t.Errorf("Insert() of an existing key allocated %v times, want 0", allocs)
+			}
+			if got := tt.lhm.Keys(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Keys() = %v, want %v", got, tt.want)
+			}
+			if got, _ := tt.lhm.Get(2); got == nil || *got != 20 {
+				t.Errorf("Get() got = %v, want %v", got, 20)
+			}
+		})
+	}
+}
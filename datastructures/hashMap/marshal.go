@@ -0,0 +1,184 @@
+package hashMap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+/*
+Snapshots of a HashMap.
+
+Binary format (version 1):
+	magic   4 bytes  "DSAH"
+	version 1 byte
+	seed    4 bytes  little endian
+	count   8 bytes  little endian, number of pairs
+	pairs   gob stream of count times key, value
+
+JSON format (version 1):
+	{"version": 1, "seed": 7757, "pairs": [{"key": ..., "value": ...}, ...]}
+
+The seed is stored, so that a reloaded HashMap puts every pair into the same bucket as before. The count is used to
+reserve all buckets before inserting, so that loading a snapshot never resizes.
+
+A crafted snapshot could choose the seed and pairs which all collide into the same bucket (hash-flooding).
+Load snapshots from untrusted sources with UnmarshalBinaryWithNewSeed or UnmarshalJSONWithNewSeed, which ignore the stored seed
+and draw a new random one instead.
+The Hasher and LoadOptions are code and not part of a snapshot. The HashMap unmarshaled into keeps its own.
+*/
+
+const snapshotVersion byte = 1
+
+var snapshotMagic = [4]byte{'D', 'S', 'A', 'H'}
+
+var (
+	ErrInvalidSnapshot     = errors.New("hashMap: invalid snapshot")
+	ErrUnsupportedSnapshot = errors.New("hashMap: unsupported snapshot version")
+)
+
+// jsonSnapshot is the JSON format of a HashMap.
+type jsonSnapshot[K, V any] struct {
+	Version byte                 `json:"version"`
+	Seed    uint32               `json:"seed"`
+	Pairs   []snapshotPair[K, V] `json:"pairs"`
+}
+
+type snapshotPair[K, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. Runtime O(n)
+//
+// Keys and values are gob encoded, so they must be supported by encoding/gob.
+func (hm *HashMap[K, V]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(snapshotMagic[:])
+	buf.WriteByte(snapshotVersion)
+	buf.Write(binary.LittleEndian.AppendUint32(nil, hm.seed))
+	buf.Write(binary.LittleEndian.AppendUint64(nil, uint64(hm.Size)))
+
+	enc := gob.NewEncoder(&buf)
+	for k, v := range hm.All() {
+		if err := enc.Encode(&k); err != nil {
+			return nil, err
+		}
+		if err := enc.Encode(&v); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. Runtime O(n)
+//
+// Replaces all pairs and the seed of the HashMap with the ones of the snapshot.
+func (hm *HashMap[K, V]) UnmarshalBinary(data []byte) error {
+	seed, pairs, err := decodeBinary[K, V](data)
+	if err != nil {
+		return err
+	}
+	return hm.restore(seed, pairs)
+}
+
+// UnmarshalBinaryWithNewSeed works like UnmarshalBinary, but draws a new random seed instead of using the stored one. Runtime O(n)
+//
+// Use it for snapshots from untrusted sources.
+func (hm *HashMap[K, V]) UnmarshalBinaryWithNewSeed(data []byte) error {
+	_, pairs, err := decodeBinary[K, V](data)
+	if err != nil {
+		return err
+	}
+	return hm.restore(randomSeed(), pairs)
+}
+
+// decodeBinary decodes the seed and the pairs of a binary snapshot.
+func decodeBinary[K, V any](data []byte) (seed uint32, pairs []snapshotPair[K, V], err error) {
+	header := len(snapshotMagic) + 1 + 4 + 8
+	if len(data) < header || !bytes.Equal(data[:len(snapshotMagic)], snapshotMagic[:]) {
+		return 0, nil, ErrInvalidSnapshot
+	}
+	data = data[len(snapshotMagic):]
+	if data[0] != snapshotVersion {
+		return 0, nil, fmt.Errorf("%w: %d", ErrUnsupportedSnapshot, data[0])
+	}
+	seed = binary.LittleEndian.Uint32(data[1:5])
+	count := binary.LittleEndian.Uint64(data[5:13])
+
+	pairs = make([]snapshotPair[K, V], 0, min(count, 1<<16))
+	dec := gob.NewDecoder(bytes.NewReader(data[13:]))
+	for range count {
+		var p snapshotPair[K, V]
+		if err := dec.Decode(&p.Key); err != nil {
+			return 0, nil, fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+		}
+		if err := dec.Decode(&p.Value); err != nil {
+			return 0, nil, fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+		}
+		pairs = append(pairs, p)
+	}
+	return seed, pairs, nil
+}
+
+// MarshalJSON implements json.Marshaler. Runtime O(n)
+func (hm *HashMap[K, V]) MarshalJSON() ([]byte, error) {
+	s := jsonSnapshot[K, V]{
+		Version: snapshotVersion,
+		Seed:    hm.seed,
+		Pairs:   make([]snapshotPair[K, V], 0, hm.Size),
+	}
+	for k, v := range hm.All() {
+		s.Pairs = append(s.Pairs, snapshotPair[K, V]{k, v})
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Runtime O(n)
+//
+// Replaces all pairs and the seed of the HashMap with the ones of the snapshot.
+func (hm *HashMap[K, V]) UnmarshalJSON(data []byte) error {
+	s, err := decodeJSON[K, V](data)
+	if err != nil {
+		return err
+	}
+	return hm.restore(s.Seed, s.Pairs)
+}
+
+// UnmarshalJSONWithNewSeed works like UnmarshalJSON, but draws a new random seed instead of using the stored one. Runtime O(n)
+//
+// Use it for snapshots from untrusted sources.
+func (hm *HashMap[K, V]) UnmarshalJSONWithNewSeed(data []byte) error {
+	s, err := decodeJSON[K, V](data)
+	if err != nil {
+		return err
+	}
+	return hm.restore(randomSeed(), s.Pairs)
+}
+
+// decodeJSON decodes a JSON snapshot.
+func decodeJSON[K, V any](data []byte) (s jsonSnapshot[K, V], err error) {
+	if err := json.Unmarshal(data, &s); err != nil {
+		return s, fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+	if s.Version != snapshotVersion {
+		return s, fmt.Errorf("%w: %d", ErrUnsupportedSnapshot, s.Version)
+	}
+	return s, nil
+}
+
+// restore replaces the content of the HashMap with pairs, hashed with seed.
+func (hm *HashMap[K, V]) restore(seed uint32, pairs []snapshotPair[K, V]) error {
+	hm.Clear()
+	hm.seed = seed
+	hm.Reserve(uint(len(pairs)))
+	for _, p := range pairs {
+		if err := hm.Insert(p.Key, p.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package hashMap
+
+import (
+	"dsa/util/sugar"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"maps"
+	"reflect"
+	"testing"
+)
+
+// Compile time checks of the implemented interfaces.
+var (
+	_ encoding.BinaryMarshaler   = (*HashMap[int, int])(nil)
+	_ encoding.BinaryUnmarshaler = (*HashMap[int, int])(nil)
+	_ json.Marshaler             = (*HashMap[int, int])(nil)
+	_ json.Unmarshaler           = (*HashMap[int, int])(nil)
+)
+
+type snapshotKey struct {
+	Name string
+	ID   int
+}
+
+// roundTrip marshals hm with marshal and unmarshals it into a new HashMap with unmarshal.
+func roundTrip[K comparable, V any](t *testing.T, hm *HashMap[K, V], marshal func(*HashMap[K, V]) ([]byte, error), unmarshal func(*HashMap[K, V], []byte) error) {
+	data, err := marshal(hm)
+	if err != nil {
+		t.Fatalf("marshal threw error: %v", err)
+	}
+	var got HashMap[K, V]
+	if err := unmarshal(&got, data); err != nil {
+		t.Fatalf("unmarshal threw error: %v", err)
+	}
+	if got.Seed() != hm.Seed() {
+		t.Errorf("Seed() = %v, want %v", got.Seed(), hm.Seed())
+	}
+	if got.Size != hm.Size {
+		t.Errorf("Size = %v, want %v", got.Size, hm.Size)
+	}
+	if !reflect.DeepEqual(maps.Collect(got.All()), maps.Collect(hm.All())) {
+		t.Errorf("pairs = %v, want %v", maps.Collect(got.All()), maps.Collect(hm.All()))
+	}
+	if got.Stats().Resizes > 1 {
+		t.Errorf("unmarshal resized %v times, want at most once", got.Stats().Resizes)
+	}
+}
+
+func binaryRoundTrip[K comparable, V any](t *testing.T, hm *HashMap[K, V]) {
+	roundTrip(t, hm, (*HashMap[K, V]).MarshalBinary, (*HashMap[K, V]).UnmarshalBinary)
+}
+
+func jsonRoundTrip[K comparable, V any](t *testing.T, hm *HashMap[K, V]) {
+	roundTrip(t, hm,
+		func(hm *HashMap[K, V]) ([]byte, error) { return json.Marshal(hm) },
+		func(hm *HashMap[K, V], data []byte) error { return json.Unmarshal(data, hm) },
+	)
+}
+
+func TestHashMap_MarshalRoundTrip(t *testing.T) {
+	intHM := NewHashMap[int, string](0)
+	stringHM := NewHashMap[string, float64](0)
+	structHM := NewHashMap[snapshotKey, []int](0)
+	for i := range 100 {
+		intHM.Insert(i, string(rune('a'+i%26)))
+		stringHM.Insert(string(rune('a'+i%26))+string(rune('A'+i/26)), float64(i)/3)
+		structHM.Insert(snapshotKey{"key", i}, []int{i, i * 2})
+	}
+	type testCase struct {
+		name string
+		run  func(t *testing.T)
+	}
+	tests := []testCase{
+		{"binary empty map", func(t *testing.T) { binaryRoundTrip(t, NewHashMap[int, int](0)) }},
+		{"binary int keys", func(t *testing.T) { binaryRoundTrip(t, intHM) }},
+		{"binary string keys", func(t *testing.T) { binaryRoundTrip(t, stringHM) }},
+		{"binary struct keys", func(t *testing.T) { binaryRoundTrip(t, structHM) }},
+		{"json empty map", func(t *testing.T) { jsonRoundTrip(t, NewHashMap[int, int](0)) }},
+		{"json int keys", func(t *testing.T) { jsonRoundTrip(t, intHM) }},
+		{"json string keys", func(t *testing.T) { jsonRoundTrip(t, stringHM) }},
+		{"json struct keys", func(t *testing.T) { jsonRoundTrip(t, structHM) }},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			tt.run(t)
+		})
+	}
+}
+
+func TestHashMap_UnmarshalKeepsHasher(t *testing.T) {
+	println()
+	t.Run("hasher and existing pairs", func(t *testing.T) {
+		defer sugar.Lite(t, "hasher and existing pairs")
+		data, _ := filledHM([]int{1, 2}, []int{1, 2}).MarshalBinary()
+		hm := NewComparableHashMap[int, int](0)
+		hm.Insert(3, 3)
+		if err := hm.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary() threw error: %v", err)
+		}
+		if _, ok := hm.Hasher().(ComparableHasher[int]); !ok {
+			t.Errorf("UnmarshalBinary() replaced the Hasher with %T", hm.Hasher())
+		}
+		if got := maps.Collect(hm.All()); !reflect.DeepEqual(got, map[int]int{1: 1, 2: 2}) {
+			t.Errorf("UnmarshalBinary() pairs = %v, want %v", got, map[int]int{1: 1, 2: 2})
+		}
+		if hm.Seed() != testSeed {
+			t.Errorf("Seed() = %v, want %v", hm.Seed(), testSeed)
+		}
+	})
+}
+
+func TestHashMap_UnmarshalWithNewSeed(t *testing.T) {
+	hm := rangeHM(100)
+	binaryData, _ := hm.MarshalBinary()
+	jsonData, _ := hm.MarshalJSON()
+	type testCase struct {
+		name      string
+		unmarshal func(hm *HashMap[int, int]) error
+	}
+	tests := []testCase{
+		{"binary", func(hm *HashMap[int, int]) error { return hm.UnmarshalBinaryWithNewSeed(binaryData) }},
+		{"json", func(hm *HashMap[int, int]) error { return hm.UnmarshalJSONWithNewSeed(jsonData) }},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			got := NewHashMapWithSeed[int, int](0, testSeed)
+			if err := tt.unmarshal(got); err != nil {
+				t.Fatalf("unmarshal threw error: %v", err)
+			}
+			// The new seed is random, so only the pairs can be checked.
+			checkLookups(t, got, rangeMap(100))
+			if got.Stats().Resizes > 1 {
+				t.Errorf("unmarshal resized %v times, want at most once", got.Stats().Resizes)
+			}
+		})
+	}
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		if err := new(HashMap[int, int]).UnmarshalBinaryWithNewSeed([]byte("DSAX")); !errors.Is(err, ErrInvalidSnapshot) {
+			t.Errorf("UnmarshalBinaryWithNewSeed() error = %v, want %v", err, ErrInvalidSnapshot)
+		}
+		if err := new(HashMap[int, int]).UnmarshalJSONWithNewSeed([]byte(`{"version": 2}`)); !errors.Is(err, ErrUnsupportedSnapshot) {
+			t.Errorf("UnmarshalJSONWithNewSeed() error = %v, want %v", err, ErrUnsupportedSnapshot)
+		}
+	})
+}
+
+func TestHashMap_UnmarshalErrors(t *testing.T) {
+	valid, _ := filledHM([]int{1, 2, 3}, []int{1, 2, 3}).MarshalBinary()
+	wrongVersion := append([]byte{}, valid...)
+	wrongVersion[4] = 99
+
+	type testCase struct {
+		name    string
+		run     func() error
+		wantErr error
+	}
+	tests := []testCase{
+		{"binary empty data", func() error { return new(HashMap[int, int]).UnmarshalBinary(nil) }, ErrInvalidSnapshot},
+		{"binary wrong magic", func() error { return new(HashMap[int, int]).UnmarshalBinary([]byte("not a snapshot at all")) }, ErrInvalidSnapshot},
+		{"binary wrong version", func() error { return new(HashMap[int, int]).UnmarshalBinary(wrongVersion) }, ErrUnsupportedSnapshot},
+		{"binary truncated pairs", func() error { return new(HashMap[int, int]).UnmarshalBinary(valid[:len(valid)-2]) }, ErrInvalidSnapshot},
+		{"binary wrong key type", func() error { return new(HashMap[string, int]).UnmarshalBinary(valid) }, ErrInvalidSnapshot},
+		{"json invalid", func() error { return json.Unmarshal([]byte(`{"pairs": 1}`), new(HashMap[int, int])) }, ErrInvalidSnapshot},
+		{"json wrong version", func() error {
+			return json.Unmarshal([]byte(`{"version": 2, "seed": 1, "pairs": []}`), new(HashMap[int, int]))
+		}, ErrUnsupportedSnapshot},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			if err := tt.run(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		// Channels can neither be gob nor json encoded.
+		hm := NewComparableHashMap[int, chan int](0)
+		hm.Insert(1, make(chan int))
+		if _, err := hm.MarshalBinary(); err == nil {
+			t.Errorf("MarshalBinary() should have thrown error")
+		}
+		if _, err := hm.MarshalJSON(); err == nil {
+			t.Errorf("MarshalJSON() should have thrown error")
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package hashMap
+
+// Option configures a HashMap on its creation.
+type Option[K any] func(o *options[K])
+
+type options[K any] struct {
+	hasher Hasher[K]
+	load   LoadOptions
+}
+
+// WithHasher lets the HashMap use hasher instead of the default Murmur3Hasher.
+func WithHasher[K any](hasher Hasher[K]) Option[K] {
+	return func(o *options[K]) {
+		o.hasher = hasher
+	}
+}
+
+// LoadOptions configures when a HashMap grows and shrinks. Zero values fall back to the defaults.
+//
+// Only the HashMap (and the stripes of the ConcurrentHashMap) use them, the RobinHoodHashMap ignores them.
+type LoadOptions struct {
+	// MaxLoadFactor is the ratio of HashMap.Size to buckets at which the HashMap grows. Defaults to 1.
+	MaxLoadFactor float64
+	// MinLoadFactor is the ratio of HashMap.Size to buckets at or below which the HashMap shrinks. Defaults to 0.25.
+	// It is clamped to at most MaxLoadFactor / 4: a resize leaves the HashMap at half of its MaxLoadFactor, so this keeps
+	// a gap of at least a factor of 2 to both triggers, and alternating Insert and Remove can not grow and shrink it every time.
+	MinLoadFactor float64
+	// KeepInitialCapacity never shrinks the HashMap below its initialCapacity. Clear also resets it to initialCapacity.
+	KeepInitialCapacity bool
+	// DisableShrink never shrinks the HashMap. Many maps in other languages work like this.
+	DisableShrink bool
+}
+
+// WithLoadOptions lets the HashMap grow and shrink according to load instead of the defaults.
+func WithLoadOptions[K any](load LoadOptions) Option[K] {
+	return func(o *options[K]) {
+		o.load = load
+	}
+}
+
+// maxLoad returns the MaxLoadFactor or its default.
+func (lo LoadOptions) maxLoad() float64 {
+	if lo.MaxLoadFactor <= 0 {
+		return 1
+	}
+	return lo.MaxLoadFactor
+}
+
+// minLoad returns the MinLoadFactor clamped to MaxLoadFactor / 4, or its default.
+func (lo LoadOptions) minLoad() float64 {
+	if lo.MinLoadFactor <= 0 {
+		return lo.maxLoad() / 4
+	}
+	return min(lo.MinLoadFactor, lo.maxLoad()/4)
+}
+
+// exceedsMaxLoad reports whether size pairs in buckets buckets are above the MaxLoadFactor.
+func (lo LoadOptions) exceedsMaxLoad(size, buckets uint) bool {
+	return float64(size) > lo.maxLoad()*float64(buckets)
+}
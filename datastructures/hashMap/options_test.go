@@ -0,0 +1,186 @@
+package hashMap
+
+import (
+	"dsa/util/sugar"
+	"testing"
+)
+
+func TestWithLoadOptions(t *testing.T) {
+	type testCase struct {
+		name            string
+		initialCapacity uint
+		load            LoadOptions
+		// wantMaxBuckets is the highest number of buckets allowed after inserting n pairs.
+		wantMaxBuckets int
+		// wantMinBucketsAfterRemove is the lowest number of buckets allowed after removing all pairs again.
+		wantMinBucketsAfterRemove int
+		// wantMaxBucketsAfterRemove is the highest number of buckets allowed after removing all pairs again.
+		wantMaxBucketsAfterRemove int
+		wantBucketsAfterClear     int
+	}
+	n := 100
+	tests := []testCase{
+		{"defaults", 0, LoadOptions{}, 2 * n, 1, 1, 1},
+		{"max load factor 4", 0, LoadOptions{MaxLoadFactor: 4}, n / 2, 1, 1, 1},
+		{"min load factor falls back if too high", 0, LoadOptions{MaxLoadFactor: 0.1, MinLoadFactor: 0.1}, 20 * n, 10, 10, 1},
+		{"keep initial capacity", 64, LoadOptions{KeepInitialCapacity: true}, 2 * n, 64, 64, 64},
+		{"disable shrink", 0, LoadOptions{DisableShrink: true}, 2 * n, n, 2 * n, 1},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			hm := NewHashMapWithSeed[int, int](tt.initialCapacity, testSeed, WithLoadOptions[int](tt.load))
+			for i := range n {
+				hm.Insert(i, i)
+				if float64(hm.Size) > hm.load.maxLoad()*float64(len(hm.Pairs)) {
+					t.Fatalf("Insert(), Size = %v exceeds MaxLoadFactor with %v buckets", hm.Size, len(hm.Pairs))
+				}
+			}
+			if len(hm.Pairs) > tt.wantMaxBuckets {
+				t.Errorf("Insert(), buckets = %v, want at most %v", len(hm.Pairs), tt.wantMaxBuckets)
+			}
+			for i := range n {
+				if got, _ := hm.Remove(i); got == nil || *got != i {
+					t.Fatalf("Remove(%v) got = %v, want %v", i, got, i)
+				}
+			}
+			if len(hm.Pairs) < tt.wantMinBucketsAfterRemove || len(hm.Pairs) > tt.wantMaxBucketsAfterRemove {
+				t.Errorf("Remove(), buckets = %v, want between %v and %v", len(hm.Pairs), tt.wantMinBucketsAfterRemove, tt.wantMaxBucketsAfterRemove)
+			}
+			hm.Clear()
+			if len(hm.Pairs) != tt.wantBucketsAfterClear {
+				t.Errorf("Clear(), buckets = %v, want %v", len(hm.Pairs), tt.wantBucketsAfterClear)
+			}
+		})
+	}
+}
+
+func TestWithLoadOptions_NoThrashing(t *testing.T) {
+	type testCase struct {
+		name string
+		load LoadOptions
+	}
+	tests := []testCase{
+		{"defaults", LoadOptions{}},
+		{"min load factor just below half of max", LoadOptions{MaxLoadFactor: 1, MinLoadFactor: 0.49}},
+		{"min load factor above max", LoadOptions{MaxLoadFactor: 0.5, MinLoadFactor: 2}},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			hm := NewHashMapWithSeed[int, int](0, testSeed, WithLoadOptions[int](tt.load))
+			for i := range 1000 {
+				hm.Insert(i, i)
+			}
+			// Remove pairs until the HashMap has just shrunk, then add and remove one pair at the boundary
+			// and remove more pairs.
+			resizes := hm.resizes
+			next := 999
+			for hm.resizes == resizes {
+				hm.Remove(next)
+				next--
+			}
+			checkStable(t, hm, next+1)
+			// A quarter of the pairs can be removed without shrinking again.
+			resizes = hm.resizes
+			for range hm.Size / 4 {
+				hm.Remove(next)
+				next--
+			}
+			if hm.resizes != resizes {
+				t.Errorf("Remove() after a shrink resized %v more times, want 0", hm.resizes-resizes)
+			}
+
+			// Insert pairs until the HashMap has just grown, then remove and add one pair at the boundary.
+			resizes = hm.resizes
+			for hm.resizes == resizes {
+				next++
+				hm.Insert(next, next)
+			}
+			checkStable(t, hm, next)
+		})
+	}
+}
+
+// checkStable alternates Insert and Remove of key and makes sure that the HashMap neither grows nor shrinks.
+//
+// If key exists, it is removed and inserted again, otherwise inserted and removed again.
+func checkStable(t *testing.T, hm *HashMap[int, int], key int) {
+	t.Helper()
+	buckets, resizes := len(hm.Pairs), hm.resizes
+	found, _ := hm.ContainsKey(key)
+	for range 10 {
+		if found {
+			hm.Remove(key)
+			hm.Insert(key, key)
+		} else {
+			hm.Insert(key, key)
+			hm.Remove(key)
+		}
+	}
+	if len(hm.Pairs) != buckets || hm.resizes != resizes {
+		t.Errorf("alternating Insert() and Remove() resized %v times to %v buckets, want %v buckets", hm.resizes-resizes, len(hm.Pairs), buckets)
+	}
+}
+
+func TestHashMap_Reserve(t *testing.T) {
+	type testCase struct {
+		name        string
+		hm          *HashMap[int, int]
+		n           uint
+		wantBuckets int
+	}
+	tests := []testCase{
+		{"reserve 0", NewHashMapWithSeed[int, int](0, testSeed), 0, 0},
+		{"reserve 1000", NewHashMapWithSeed[int, int](0, testSeed), 1000, 1000},
+		{"reserve less than capacity", NewHashMapWithSeed[int, int](10, testSeed), 5, 10},
+		{"reserve with max load factor 2", NewHashMapWithSeed[int, int](0, testSeed, WithLoadOptions[int](LoadOptions{MaxLoadFactor: 2})), 1000, 500},
+		{"reserve filled map", filledHM([]int{-1, -2, -3}, []int{-1, -2, -3}), 1000, 1000},
+		// 63 / 0.7 rounds to 90 buckets, but 0.7 * 90 < 63.
+		{"reserve with rounding error", NewHashMapWithSeed[int, int](0, testSeed, WithLoadOptions[int](LoadOptions{MaxLoadFactor: 0.7})), 63, 91},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			size := tt.hm.Size
+			tt.hm.Reserve(tt.n)
+			if len(tt.hm.Pairs) != tt.wantBuckets || tt.hm.oldPairs != nil {
+				t.Errorf("Reserve(), buckets = %v, rehashing = %v, want %v buckets", len(tt.hm.Pairs), tt.hm.oldPairs != nil, tt.wantBuckets)
+			}
+			// Filling the reserved pairs must not resize the map.
+			resizes := tt.hm.resizes
+			for i := range int(tt.n - size) {
+				tt.hm.Insert(i, i)
+				if len(tt.hm.Pairs) != tt.wantBuckets {
+					t.Fatalf("Insert() after Reserve() resized to %v buckets", len(tt.hm.Pairs))
+				}
+			}
+			if tt.hm.resizes != resizes {
+				t.Errorf("Insert() after Reserve() resized %v times, want 0", tt.hm.resizes-resizes)
+			}
+			if tt.hm.Size != max(tt.n, size) {
+				t.Errorf("Size = %v, want %v", tt.hm.Size, max(tt.n, size))
+			}
+		})
+	}
+	println()
+	t.Run("no resize for any n and load factor", func(t *testing.T) {
+		defer sugar.Lite(t, "no resize for any n and load factor")
+		for _, maxLoad := range []float64{0.1, 0.3, 0.35, 0.7, 0.75, 0.9, 1, 1.7, 3} {
+			for n := uint(1); n <= 300; n++ {
+				hm := NewHashMapWithSeed[int, int](0, testSeed, WithLoadOptions[int](LoadOptions{MaxLoadFactor: maxLoad}))
+				hm.Reserve(n)
+				resizes := hm.resizes
+				for i := range int(n) {
+					hm.Insert(i, i)
+				}
+				if hm.resizes != resizes {
+					t.Errorf("Reserve(%v) with MaxLoadFactor %v, then %v inserts resized %v times, want 0", n, maxLoad, n, hm.resizes-resizes)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,222 @@
+package hashMap
+
+import (
+	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+	"dsa/util/sugar"
+	"maps"
+	"math/rand"
+	"testing"
+)
+
+/*
+Property based tests: thousands of random operations are run against the HashMap and against Go's built-in map as oracle.
+After every operation both have to agree on the result and the size, and every few operations on all pairs.
+
+The keys are drawn from a small key space, so that inserts, replacements and removals of existing keys are all common,
+and the map grows and shrinks through many (incremental) resizes. The random source has a fixed seed per case,
+so a failure can be reproduced with the printed step.
+*/
+
+const propertySteps = 20000
+
+// checkOracle fails the test if hm does not contain exactly the pairs of oracle.
+func checkOracle(t *testing.T, step int, hm *HashMap[int, int], oracle map[int]int) {
+	t.Helper()
+	if hm.Size != uint(len(oracle)) {
+		t.Fatalf("step %v: Size = %v, want %v", step, hm.Size, len(oracle))
+	}
+	if got := maps.Collect(hm.All()); !maps.Equal(got, oracle) {
+		t.Fatalf("step %v: All() = %v, want %v", step, got, oracle)
+	}
+	if got := hm.Keys(); len(got) != len(oracle) {
+		t.Fatalf("step %v: Keys() has %v keys, want %v", step, len(got), len(oracle))
+	}
+	if got := hm.Values(); len(got) != len(oracle) {
+		t.Fatalf("step %v: Values() has %v values, want %v", step, len(got), len(oracle))
+	}
+}
+
+func TestHashMap_PropertyAgainstMap(t *testing.T) {
+	type testCase struct {
+		name     string
+		hm       *HashMap[int, int]
+		keySpace int
+	}
+	tests := []testCase{
+		{"default", NewHashMapWithSeed[int, int](0, testSeed), 64},
+		{"large key space", NewHashMapWithSeed[int, int](0, testSeed), 4096},
+		{"comparable hasher", NewHashMapWithSeed[int, int](1, testSeed, WithHasher[int](ComparableHasher[int]{})), 256},
+		{"high load factor", NewHashMapWithSeed[int, int](0, testSeed, WithLoadOptions[int](LoadOptions{MaxLoadFactor: 4})), 256},
+		{"low load factor", NewHashMapWithSeed[int, int](0, testSeed, WithLoadOptions[int](LoadOptions{MaxLoadFactor: 0.5, MinLoadFactor: 0.2})), 256},
+		{"keep initial capacity", NewHashMapWithSeed[int, int](50, testSeed, WithLoadOptions[int](LoadOptions{KeepInitialCapacity: true})), 256},
+		{"shrink disabled", NewHashMapWithSeed[int, int](0, testSeed, WithLoadOptions[int](LoadOptions{DisableShrink: true})), 256},
+		{"constant hasher", NewHashMapWithSeed[int, int](0, testSeed, WithHasher[int](constantHasher{})), 32},
+		{"zero value", &HashMap[int, int]{}, 64},
+		{
+			"sparse literal with nil buckets",
+			&HashMap[int, int]{seed: testSeed, Pairs: make([]*doublyLinkedListHM.LinkedList[int, int], 16)},
+			64,
+		},
+	}
+	for i, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			rnd := rand.New(rand.NewSource(int64(i)))
+			hm := tt.hm
+			oracle := make(map[int]int)
+
+			for step := range propertySteps {
+				key := rnd.Intn(tt.keySpace)
+				val := rnd.Int()
+				op := rnd.Intn(100)
+
+				switch {
+				case op < 30:
+					if err := hm.Insert(key, val); err != nil {
+						t.Fatalf("step %v: Insert(%v) error = %v", step, key, err)
+					}
+					oracle[key] = val
+				case op < 55:
+					got, err := hm.Remove(key)
+					want, ok := oracle[key]
+					if err != nil || (got != nil) != ok || (ok && *got != want) {
+						t.Fatalf("step %v: Remove(%v) = %v, %v, want %v, %v", step, key, got, err, want, ok)
+					}
+					delete(oracle, key)
+				case op < 75:
+					got, err := hm.Get(key)
+					want, ok := oracle[key]
+					if err != nil || (got != nil) != ok || (ok && *got != want) {
+						t.Fatalf("step %v: Get(%v) = %v, %v, want %v, %v", step, key, got, err, want, ok)
+					}
+				case op < 85:
+					found, err := hm.ContainsKey(key)
+					if _, ok := oracle[key]; err != nil || found != ok {
+						t.Fatalf("step %v: ContainsKey(%v) = %v, %v, want %v", step, key, found, err, ok)
+					}
+				case op < 90:
+					inserted, err := hm.InsertIfAbsent(key, val)
+					_, ok := oracle[key]
+					if err != nil || inserted == ok {
+						t.Fatalf("step %v: InsertIfAbsent(%v) = %v, %v, want %v", step, key, inserted, err, !ok)
+					}
+					if !ok {
+						oracle[key] = val
+					}
+				case op < 95:
+					err := hm.Update(key, func(old int, ok bool) int {
+						if want, wantOk := oracle[key]; ok != wantOk || old != want {
+							t.Fatalf("step %v: Update(%v) got old = %v, %v, want %v, %v", step, key, old, ok, want, wantOk)
+						}
+						return old + 1
+					})
+					if err != nil {
+						t.Fatalf("step %v: Update(%v) error = %v", step, key, err)
+					}
+					oracle[key]++
+				case op < 99:
+					got, loaded, err := hm.GetOrInsert(key, val)
+					want, ok := oracle[key]
+					if !ok {
+						want = val
+						oracle[key] = val
+					}
+					if err != nil || loaded != ok || *got != want {
+						t.Fatalf("step %v: GetOrInsert(%v) = %v, %v, %v, want %v, %v", step, key, *got, loaded, err, want, ok)
+					}
+				default:
+					hm.Clear()
+					clear(oracle)
+				}
+
+				if hm.Size != uint(len(oracle)) {
+					t.Fatalf("step %v: Size = %v, want %v", step, hm.Size, len(oracle))
+				}
+				if step%500 == 0 {
+					checkOracle(t, step, hm, oracle)
+				}
+			}
+			checkOracle(t, propertySteps, hm, oracle)
+		})
+	}
+}
+
+func TestRobinHoodHashMap_PropertyAgainstMap(t *testing.T) {
+	type testCase struct {
+		name     string
+		rh       *RobinHoodHashMap[int, int]
+		keySpace int
+	}
+	tests := []testCase{
+		{"default", NewRobinHoodHashMapWithSeed[int, int](0, testSeed), 64},
+		{"large key space", NewRobinHoodHashMapWithSeed[int, int](0, testSeed), 4096},
+		{"constant hasher", NewRobinHoodHashMapWithSeed[int, int](0, testSeed, WithHasher[int](constantHasher{})), 32},
+		{"zero value", &RobinHoodHashMap[int, int]{}, 64},
+	}
+	for i, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			rnd := rand.New(rand.NewSource(int64(i)))
+			rh := tt.rh
+			oracle := make(map[int]int)
+
+			for step := range propertySteps {
+				key := rnd.Intn(tt.keySpace)
+				val := rnd.Int()
+				op := rnd.Intn(100)
+
+				switch {
+				case op < 40:
+					if err := rh.Insert(key, val); err != nil {
+						t.Fatalf("step %v: Insert(%v) error = %v", step, key, err)
+					}
+					oracle[key] = val
+				case op < 70:
+					got, err := rh.Remove(key)
+					want, ok := oracle[key]
+					if err != nil || (got != nil) != ok || (ok && *got != want) {
+						t.Fatalf("step %v: Remove(%v) = %v, %v, want %v, %v", step, key, got, err, want, ok)
+					}
+					delete(oracle, key)
+				case op < 90:
+					got, err := rh.Get(key)
+					want, ok := oracle[key]
+					if err != nil || (got != nil) != ok || (ok && *got != want) {
+						t.Fatalf("step %v: Get(%v) = %v, %v, want %v, %v", step, key, got, err, want, ok)
+					}
+				case op < 99:
+					found, err := rh.ContainsKey(key)
+					if _, ok := oracle[key]; err != nil || found != ok {
+						t.Fatalf("step %v: ContainsKey(%v) = %v, %v, want %v", step, key, found, err, ok)
+					}
+				default:
+					rh.Clear()
+					clear(oracle)
+				}
+
+				if rh.Size != uint(len(oracle)) {
+					t.Fatalf("step %v: Size = %v, want %v", step, rh.Size, len(oracle))
+				}
+				if step%500 == 0 && !slicesEqualUnordered(rh.Keys(), mapKeys(oracle)) {
+					t.Fatalf("step %v: Keys() = %v, want %v", step, rh.Keys(), mapKeys(oracle))
+				}
+			}
+		})
+	}
+}
+
+// constantHasher puts every key into the same bucket, so that all operations work on long chains and probe sequences.
+type constantHasher struct{}
+
+func (constantHasher) Hash(int, uint32) (uint32, error) { return 42, nil }
+func (constantHasher) Equal(a, b int) bool              { return a == b }
+
+func mapKeys(m map[int]int) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,249 @@
+package hashMap
+
+/*
+Open addressing variant of the HashMap.
+
+Instead of a linked list per bucket, every key-value pair is stored directly in the slots array.
+On a collision the pair is placed in one of the following slots (linear probing).
+
+Robin Hood hashing: Every pair remembers how far it is away from its home slot (the slot its hash points to).
+While inserting, a pair that is further away from its home than the pair sitting in the slot "steals" the slot from
+the "richer" pair, which then continues probing. This keeps all probe distances short and similar.
+A lookup can stop as soon as it sees a pair that is closer to its home than the searched key would be at this point,
+because Robin Hood insertion would have placed the searched key there.
+
+Backward shift deletion: Instead of leaving tombstones behind, the pairs after a removed pair are shifted back by one
+slot until a pair is found that is already in its home slot (or an empty slot).
+*/
+
+// robinHoodMaxLoad is the load factor in eighths at which the RobinHoodHashMap grows. Open addressing needs free slots,
+// so unlike HashMap it can never be filled to a load factor of 1.
+const robinHoodMaxLoad = 7
+
+type robinHoodSlot[K, V any] struct {
+	key   K
+	value V
+	hash  uint32
+	// dist is the probe distance + 1 from the home slot of the key. 0 marks an empty slot.
+	dist uint
+}
+
+type RobinHoodHashMap[K, V any] struct {
+	slots []robinHoodSlot[K, V]
+	Size  uint
+	// hasher is nil for the default Murmur3Hasher.
+	hasher Hasher[K]
+	seed   uint32
+}
+
+// NewRobinHoodHashMap creates a new open addressing HashMap with a random seed. Runtime O(n)
+//
+// initialCapacity - The initial amount of slots of the RobinHoodHashMap on its creation.
+//
+// opts - Optional configuration, e.g. WithHasher to replace the default Murmur3Hasher.
+func NewRobinHoodHashMap[K, V any](initialCapacity uint, opts ...Option[K]) *RobinHoodHashMap[K, V] {
+	return NewRobinHoodHashMapWithSeed[K, V](initialCapacity, randomSeed(), opts...)
+}
+
+// NewRobinHoodHashMapWithSeed creates a new open addressing HashMap with a fixed seed. Runtime O(n)
+//
+// See NewHashMapWithSeed for when a fixed seed is safe to use.
+func NewRobinHoodHashMapWithSeed[K, V any](initialCapacity uint, seed uint32, opts ...Option[K]) *RobinHoodHashMap[K, V] {
+	var o options[K]
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &RobinHoodHashMap[K, V]{
+		slots:  make([]robinHoodSlot[K, V], initialCapacity),
+		Size:   0,
+		hasher: o.hasher,
+		seed:   seed,
+	}
+}
+
+// Get value by key. Runtime O(1)
+//
+// Returns nil if no value was found
+func (rh *RobinHoodHashMap[K, V]) Get(key K) (val *V, err error) {
+	if len(rh.slots) == 0 {
+		return val, nil
+	}
+
+	i, err := rh.find(key)
+	if err != nil || i < 0 {
+		return val, err
+	}
+	return &rh.slots[i].value, nil
+}
+
+// Insert a key value pair or replace the value of an existing key. Runtime average case O(1), worst case O(n) when upsizing.
+//
+// Upsizes the RobinHoodHashMap to double its slots if it is filled to 7/8.
+func (rh *RobinHoodHashMap[K, V]) Insert(key K, val V) error {
+	h, err := rh.Hasher().Hash(key, rh.seed)
+	if err != nil {
+		return err
+	}
+
+	// Replacing the value of an existing key must happen before Robin Hood swaps move the pairs around.
+	if len(rh.slots) > 0 {
+		if i := rh.findHash(key, h); i >= 0 {
+			rh.slots[i].value = val
+			return nil
+		}
+	}
+
+	if (rh.Size+1)*8 > uint(len(rh.slots))*robinHoodMaxLoad {
+		rh.resize(max(uint(len(rh.slots))*2, 8))
+	}
+
+	rh.place(robinHoodSlot[K, V]{key, val, h, 1})
+	rh.Size++
+	return nil
+}
+
+// Remove key value pair by key. Runtime average case: O(1), worst case O(n)
+//
+// Returns the value or nil if no value was found.
+//
+// Downsizes the RobinHoodHashMap to half its slots if it is filled to less than 1/4.
+func (rh *RobinHoodHashMap[K, V]) Remove(key K) (val *V, err error) {
+	if len(rh.slots) == 0 {
+		return val, nil
+	}
+
+	i, err := rh.find(key)
+	if err != nil || i < 0 {
+		return val, err
+	}
+
+	value := rh.slots[i].value
+
+	// Backward shift: move every following pair which is not in its home slot one slot closer to its home.
+	n := len(rh.slots)
+	next := (i + 1) % n
+	for rh.slots[next].dist > 1 {
+		rh.slots[i] = rh.slots[next]
+		rh.slots[i].dist--
+		i = next
+		next = (next + 1) % n
+	}
+	rh.slots[i] = robinHoodSlot[K, V]{}
+	rh.Size--
+
+	if len(rh.slots) > 8 && uint(len(rh.slots))>>2 > rh.Size {
+		rh.resize(uint(len(rh.slots)) >> 1)
+	}
+
+	return &value, nil
+}
+
+// ContainsKey - Check if key exists. Runtime O(1)
+func (rh *RobinHoodHashMap[K, V]) ContainsKey(key K) (bool, error) {
+	if len(rh.slots) == 0 {
+		return false, nil
+	}
+
+	i, err := rh.find(key)
+	return i >= 0, err
+}
+
+// IsEmpty - Check if map is emtpy. Runtime O(1)
+func (rh *RobinHoodHashMap[K, V]) IsEmpty() bool {
+	return rh.Size == 0
+}
+
+// Keys returns an array of all keys. Runtime O(n)
+func (rh *RobinHoodHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, rh.Size)
+	for _, s := range rh.slots {
+		if s.dist > 0 {
+			keys = append(keys, s.key)
+		}
+	}
+	return keys
+}
+
+// Values returns an array of all values. Runtime O(n)
+func (rh *RobinHoodHashMap[K, V]) Values() []V {
+	values := make([]V, 0, rh.Size)
+	for _, s := range rh.slots {
+		if s.dist > 0 {
+			values = append(values, s.value)
+		}
+	}
+	return values
+}
+
+// Clear RobinHoodHashMap, freeing all of its slots. Runtime O(1)
+func (rh *RobinHoodHashMap[K, V]) Clear() {
+	rh.slots = nil
+	rh.Size = 0
+}
+
+// Hasher returns the Hasher used by the RobinHoodHashMap.
+func (rh *RobinHoodHashMap[K, V]) Hasher() Hasher[K] {
+	if rh.hasher == nil {
+		return Murmur3Hasher[K]{}
+	}
+	return rh.hasher
+}
+
+// find returns the slot index of key, or -1 if key does not exist.
+func (rh *RobinHoodHashMap[K, V]) find(key K) (int, error) {
+	h, err := rh.Hasher().Hash(key, rh.seed)
+	if err != nil {
+		return -1, err
+	}
+	return rh.findHash(key, h), nil
+}
+
+// findHash returns the slot index of key with hash h, or -1 if key does not exist.
+func (rh *RobinHoodHashMap[K, V]) findHash(key K, h uint32) int {
+	n := len(rh.slots)
+	i := bucketIndex(h, n)
+	for dist := uint(1); ; dist++ {
+		s := &rh.slots[i]
+		// An empty slot or a pair closer to its home than we are means the key would have been placed here.
+		if s.dist < dist {
+			return -1
+		}
+		if s.hash == h && rh.Hasher().Equal(s.key, key) {
+			return i
+		}
+		i = (i + 1) % n
+	}
+}
+
+// place inserts a new pair, swapping it with every richer pair on its way. Runtime average case O(1)
+func (rh *RobinHoodHashMap[K, V]) place(pair robinHoodSlot[K, V]) {
+	n := len(rh.slots)
+	i := bucketIndex(pair.hash, n)
+	for {
+		s := &rh.slots[i]
+		if s.dist == 0 {
+			*s = pair
+			return
+		}
+		if s.dist < pair.dist {
+			*s, pair = pair, *s
+		}
+		pair.dist++
+		i = (i + 1) % n
+	}
+}
+
+// resize places every pair into a new slots array of the given size. Runtime O(n)
+//
+// The hashes are stored in the slots, so no key has to be hashed again.
+func (rh *RobinHoodHashMap[K, V]) resize(size uint) {
+	oldSlots := rh.slots
+	rh.slots = make([]robinHoodSlot[K, V], size)
+	for _, s := range oldSlots {
+		if s.dist > 0 {
+			s.dist = 1
+			rh.place(s)
+		}
+	}
+}
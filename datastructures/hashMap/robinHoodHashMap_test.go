@@ -0,0 +1,96 @@
+package hashMap
+
+import (
+	"dsa/util/sugar"
+	"fmt"
+	"testing"
+)
+
+/*
+The RobinHoodHashMap is held to the same behavior as the HashMap by the test tables in hashMap_test.go.
+Every HashMap fixture there is also converted into a RobinHoodHashMap with robinHoodFrom, and its results are compared
+to the same wanted results.
+*/
+
+// robinHoodFrom creates a RobinHoodHashMap with the same pairs, slot count, hasher and seed as hm.
+func robinHoodFrom[K, V any](hm *HashMap[K, V]) *RobinHoodHashMap[K, V] {
+	rh := NewRobinHoodHashMapWithSeed[K, V](uint(len(hm.Pairs)), hm.seed, WithHasher(hm.Hasher()))
+	for _, p := range hm.Pairs {
+		if p == nil {
+			continue
+		}
+		for node := p.Head; node != nil; node = node.Next {
+			rh.Insert(node.Key, node.Value)
+		}
+	}
+	return rh
+}
+
+// robinHoodEqual checks if rh contains the same key value pairs as hm.
+func robinHoodEqual(rh *RobinHoodHashMap[int, int], hm *HashMap[int, int]) bool {
+	if rh.Size != hm.Size {
+		return false
+	}
+	for _, p := range hm.Pairs {
+		if p == nil {
+			continue
+		}
+		for node := p.Head; node != nil; node = node.Next {
+			if v, _ := rh.Get(node.Key); v == nil || *v != node.Value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestRobinHoodHashMap_Upsert(t *testing.T) {
+	println()
+	t.Run("insert existing key replaces value", func(t *testing.T) {
+		defer sugar.Lite(t, "insert existing key replaces value")
+		rh := NewRobinHoodHashMapWithSeed[int, int](0, testSeed)
+		rh.Insert(1, 1)
+		rh.Insert(1, 2)
+		if got, _ := rh.Get(1); got == nil || *got != 2 {
+			t.Errorf("Get() got = %v, want %v", got, 2)
+		}
+		if rh.Size != 1 {
+			t.Errorf("Size = %v, want %v", rh.Size, 1)
+		}
+	})
+}
+
+func TestRobinHoodHashMap_ManyPairs(t *testing.T) {
+	println()
+	t.Run("grow, shrink and backward shift", func(t *testing.T) {
+		defer sugar.Lite(t, "grow, shrink and backward shift")
+		rh := NewRobinHoodHashMapWithSeed[string, int](0, testSeed, WithHasher[string](ComparableHasher[string]{}))
+		n := 2000
+		for i := range n {
+			if err := rh.Insert(fmt.Sprint(i), i); err != nil {
+				t.Fatalf("Insert() threw error: %v", err)
+			}
+		}
+		// Remove every even key, so that lots of pairs have to be shifted back.
+		for i := 0; i < n; i += 2 {
+			if got, _ := rh.Remove(fmt.Sprint(i)); got == nil || *got != i {
+				t.Fatalf("Remove(%v) got = %v, want %v", i, got, i)
+			}
+		}
+		for i := range n {
+			got, _ := rh.Get(fmt.Sprint(i))
+			if i%2 == 0 && got != nil {
+				t.Errorf("Get(%v) got = %v, want nil", i, *got)
+			}
+			if i%2 == 1 && (got == nil || *got != i) {
+				t.Errorf("Get(%v) got = %v, want %v", i, got, i)
+			}
+		}
+		for i := 1; i < n; i += 2 {
+			rh.Remove(fmt.Sprint(i))
+		}
+		if !rh.IsEmpty() || len(rh.slots) > 8 {
+			t.Errorf("Size = %v, slots = %v, want empty map with shrunk slots", rh.Size, len(rh.slots))
+		}
+	})
+}
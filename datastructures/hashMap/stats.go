@@ -0,0 +1,113 @@
+package hashMap
+
+import (
+	"dsa/util/color"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Stats describe how the pairs of a HashMap are distributed over its buckets.
+//
+// Use them to compare Hashers objectively: a good hash function leaves few buckets empty and keeps the chains short.
+type Stats struct {
+	Size    uint
+	Buckets int
+	// LoadFactor is Size / Buckets.
+	LoadFactor   float64
+	EmptyBuckets int
+	// ChainLengths is a histogram of the bucket chain lengths. ChainLengths[i] is the number of buckets holding i pairs.
+	ChainLengths []int
+	// MaxChainLength is the length of the longest chain, which is the worst case of a lookup.
+	MaxChainLength int
+	// MeanChainLength is the mean length of all non-empty chains.
+	MeanChainLength float64
+	// Resizes is the number of times the HashMap started a resize since it was created.
+	Resizes uint
+}
+
+// Stats calculates the bucket distribution of the HashMap. Runtime O(n)
+//
+// While rehashing, the pairs which are still in the old buckets are counted in the new bucket they will be moved to,
+// so the Stats are the same as after the rehash. The drained old buckets would make the map look emptier than it is.
+func (hm *HashMap[K, V]) Stats() Stats {
+	s := Stats{
+		Size:         hm.Size,
+		Buckets:      len(hm.Pairs),
+		ChainLengths: make([]int, 1),
+		Resizes:      hm.resizes,
+	}
+
+	lengths := make([]int, len(hm.Pairs))
+	for i, p := range hm.Pairs {
+		if p != nil {
+			lengths[i] = int(p.Size)
+		}
+	}
+	if hm.oldPairs != nil {
+		for _, p := range hm.oldPairs[hm.rehashIndex:] {
+			if p == nil {
+				continue
+			}
+			for node := p.Head; node != nil; node = node.Next {
+				// The key was hashed successfully when it was inserted, so it can be hashed again.
+				h, _ := hm.Hasher().Hash(node.Key, hm.seed)
+				lengths[bucketIndex(h, len(lengths))]++
+			}
+		}
+	}
+
+	for _, length := range lengths {
+		for len(s.ChainLengths) <= length {
+			s.ChainLengths = append(s.ChainLengths, 0)
+		}
+		s.ChainLengths[length]++
+		s.MaxChainLength = max(s.MaxChainLength, length)
+	}
+
+	s.EmptyBuckets = s.ChainLengths[0]
+	if s.Buckets > 0 {
+		s.LoadFactor = float64(s.Size) / float64(s.Buckets)
+	}
+	if used := s.Buckets - s.EmptyBuckets; used > 0 {
+		s.MeanChainLength = float64(s.Size) / float64(used)
+	}
+	return s
+}
+
+// Print writes the Stats as a colored table to stdout. Use name as identifier.
+func (s Stats) Print(name string) {
+	s.Fprint(os.Stdout, name)
+}
+
+// Fprint writes the Stats as a colored table to w. Use name as identifier.
+func (s Stats) Fprint(w io.Writer, name string) {
+	emptyPercent := 0.0
+	if s.Buckets > 0 {
+		emptyPercent = float64(s.EmptyBuckets) / float64(s.Buckets) * 100
+	}
+
+	fmt.Fprintf(w, "%s[ %s ] HashMap stats%s\n", color.Magenta, name, color.Reset)
+	fmt.Fprintf(w, "%s%-18s%s %d\n", color.Cyan, "size", color.Reset, s.Size)
+	fmt.Fprintf(w, "%s%-18s%s %d\n", color.Cyan, "buckets", color.Reset, s.Buckets)
+	fmt.Fprintf(w, "%s%-18s%s %.3f\n", color.Cyan, "load factor", color.Reset, s.LoadFactor)
+	fmt.Fprintf(w, "%s%-18s%s %d (%.1f%%)\n", color.Cyan, "empty buckets", color.Reset, s.EmptyBuckets, emptyPercent)
+	fmt.Fprintf(w, "%s%-18s%s %d\n", color.Cyan, "max chain length", color.Reset, s.MaxChainLength)
+	fmt.Fprintf(w, "%s%-18s%s %.3f\n", color.Cyan, "mean chain length", color.Reset, s.MeanChainLength)
+	fmt.Fprintf(w, "%s%-18s%s %d\n", color.Cyan, "resizes", color.Reset, s.Resizes)
+
+	// Histogram of the chain lengths, scaled to a bar of at most 40 characters.
+	most := 0
+	for _, count := range s.ChainLengths {
+		most = max(most, count)
+	}
+	fmt.Fprintf(w, "%s%-8s %-10s%s\n", color.Blue, "length", "buckets", color.Reset)
+	for length, count := range s.ChainLengths {
+		bar := 0
+		if most > 0 {
+			bar = count * 40 / most
+		}
+		fmt.Fprintf(w, "%-8d %-10d %s%s%s\n", length, count, color.Green, strings.Repeat("#", bar), color.Reset)
+	}
+}
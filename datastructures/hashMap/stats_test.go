@@ -0,0 +1,105 @@
+package hashMap
+
+import (
+	"bytes"
+	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+	"dsa/util/sugar"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestHashMap_Stats(t *testing.T) {
+	type testCase struct {
+		name string
+		hm   *HashMap[int, int]
+		want Stats
+	}
+	tests := []testCase{
+		{
+			"empty map",
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{}, Size: 0},
+			Stats{ChainLengths: []int{0}},
+		},
+		{
+			"filled map",
+			&HashMap[int, int]{seed: testSeed, Pairs: []*doublyLinkedListHM.LinkedList[int, int]{
+				ll[int, int]([]int{1}, []int{4}),
+				nil,
+				ll[int, int]([]int{2, 3, 5}, []int{5, 6, 7}),
+				nil,
+			}, Size: 4, resizes: 2},
+			Stats{
+				Size:            4,
+				Buckets:         4,
+				LoadFactor:      1,
+				EmptyBuckets:    2,
+				ChainLengths:    []int{2, 1, 0, 1},
+				MaxChainLength:  3,
+				MeanChainLength: 2,
+				Resizes:         2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			if got := tt.hm.Stats(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Stats() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+	println()
+	t.Run("rehashing map counts like after the rehash", func(t *testing.T) {
+		defer sugar.Lite(t, "rehashing map counts like after the rehash")
+		hm := rehashingHM(100)
+		if hm.oldPairs == nil || hm.rehashIndex == 0 {
+			t.Fatalf("rehashingHM() is not in the middle of a rehash")
+		}
+		got := hm.Stats()
+		hm.finishRehash()
+		if want := hm.Stats(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Stats() while rehashing = %+v, want %+v", got, want)
+		}
+		if got.LoadFactor != float64(hm.Size)/float64(len(hm.Pairs)) {
+			t.Errorf("Stats().LoadFactor = %v, want %v", got.LoadFactor, float64(hm.Size)/float64(len(hm.Pairs)))
+		}
+	})
+	println()
+	t.Run("resizes are counted", func(t *testing.T) {
+		defer sugar.Lite(t, "resizes are counted")
+		hm := NewHashMapWithSeed[int, int](0, testSeed)
+		for i := range 8 {
+			hm.Insert(i, i)
+		}
+		// 0 -> 1 -> 2 -> 4 -> 8 buckets
+		if got := hm.Stats().Resizes; got != 4 {
+			t.Errorf("Stats().Resizes = %v, want %v", got, 4)
+		}
+	})
+}
+
+func TestStats_Fprint(t *testing.T) {
+	println()
+	t.Run("table", func(t *testing.T) {
+		defer sugar.Lite(t, "table")
+		var buf bytes.Buffer
+		Stats{
+			Size:            4,
+			Buckets:         4,
+			LoadFactor:      1,
+			EmptyBuckets:    2,
+			ChainLengths:    []int{2, 1, 0, 1},
+			MaxChainLength:  3,
+			MeanChainLength: 2,
+			Resizes:         2,
+		}.Fprint(&buf, "test map")
+		got := buf.String()
+		for _, want := range []string{"[ test map ]", "empty buckets", "2 (50.0%)", "max chain length", "mean chain length", "2.000", strings.Repeat("#", 40)} {
+			if !strings.Contains(got, want) {
+				t.Errorf("Fprint() = %q, does not contain %q", got, want)
+			}
+		}
+	})
+}
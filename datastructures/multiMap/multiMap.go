@@ -0,0 +1,182 @@
+package multiMap
+
+import (
+	"dsa/datastructures/doublyLinkedList/doublyLinkedListHM"
+	"dsa/datastructures/hashMap"
+	"iter"
+	"slices"
+)
+
+/*
+MultiMap maps every key to a group of values instead of a single value. The same value can be put multiple times.
+
+Every key points to its own doublyLinkedListHM.LinkedList in a hashMap.HashMap. The list holds one node per value,
+so putting a value is O(1) and removing a found node is O(1) via its Prev and Next pointers.
+Like in the buckets of the HashMap, new nodes are pushed to the Head of the list, so GetAll reverses the list
+to return the values in the order they were put.
+
+Values are compared with reflect.DeepEqual (see doublyLinkedListHM.GetNodeByValue).
+*/
+
+type MultiMap[K, V any] struct {
+	groups *hashMap.HashMap[K, *doublyLinkedListHM.LinkedList[K, V]]
+	// size is the number of values of all keys.
+	size uint
+}
+
+// NewMultiMap creates a new MultiMap with a random seed. Runtime O(n)
+//
+// initialCapacity - The initial capacity of keys of the MultiMap on its creation.
+//
+// opts - Optional configuration of the underlying HashMap, e.g. hashMap.WithHasher to replace the default Murmur3Hasher.
+func NewMultiMap[K, V any](initialCapacity uint, opts ...hashMap.Option[K]) *MultiMap[K, V] {
+	return &MultiMap[K, V]{groups: hashMap.NewHashMap[K, *doublyLinkedListHM.LinkedList[K, V]](initialCapacity, opts...)}
+}
+
+// Put adds val to the values of key. Runtime average case O(1), worst case O(n) when upsizing.
+func (mm *MultiMap[K, V]) Put(key K, val V) error {
+	group, _, err := mm.groups.GetOrInsertFunc(key, newGroup[K, V])
+	if err != nil {
+		return err
+	}
+
+	(*group).Push(key, val)
+	mm.size++
+	return nil
+}
+
+// GetAll returns all values of key in the order they were put. Runtime O(1 + values of key)
+//
+// Returns nil if key does not exist.
+func (mm *MultiMap[K, V]) GetAll(key K) ([]V, error) {
+	group, err := mm.groups.Get(key)
+	if err != nil || group == nil {
+		return nil, err
+	}
+	return values(*group), nil
+}
+
+// Count returns the number of values of key. Runtime O(1)
+func (mm *MultiMap[K, V]) Count(key K) (uint, error) {
+	group, err := mm.groups.Get(key)
+	if err != nil || group == nil {
+		return 0, err
+	}
+	return (*group).Size, nil
+}
+
+// ContainsKey - Check if key has at least one value. Runtime O(1)
+func (mm *MultiMap[K, V]) ContainsKey(key K) (bool, error) {
+	return mm.groups.ContainsKey(key)
+}
+
+// RemoveOne removes one occurrence of val from the values of key, the one put last. Runtime O(1 + values of key)
+//
+// The key is removed together with its last value.
+//
+// Returns whether val was found.
+func (mm *MultiMap[K, V]) RemoveOne(key K, val V) (bool, error) {
+	group, err := mm.groups.Get(key)
+	if err != nil || group == nil {
+		return false, err
+	}
+
+	node, found := (*group).GetNodeByValue(val)
+	if !found {
+		return false, nil
+	}
+	(*group).RemoveNode(node)
+	mm.size--
+
+	if (*group).IsEmpty() {
+		_, err = mm.groups.Remove(key)
+	}
+	return true, err
+}
+
+// RemoveAll removes key with all of its values. Runtime average case: O(1), worst case O(n) when downsizing.
+//
+// Returns the number of removed values.
+func (mm *MultiMap[K, V]) RemoveAll(key K) (uint, error) {
+	group, err := mm.groups.Remove(key)
+	if err != nil || group == nil {
+		return 0, err
+	}
+
+	mm.size -= (*group).Size
+	return (*group).Size, nil
+}
+
+// Len returns the number of values of all keys. Runtime O(1)
+func (mm *MultiMap[K, V]) Len() uint {
+	return mm.size
+}
+
+// KeyCount returns the number of keys. Runtime O(1)
+func (mm *MultiMap[K, V]) KeyCount() uint {
+	return mm.groups.Size
+}
+
+// IsEmpty - Check if MultiMap is emtpy. Runtime O(1)
+func (mm *MultiMap[K, V]) IsEmpty() bool {
+	return mm.size == 0
+}
+
+// Keys returns an array of all keys. Runtime O(keys)
+func (mm *MultiMap[K, V]) Keys() []K {
+	return mm.groups.Keys()
+}
+
+// Groups returns an iterator over all keys with all of their values in the order they were put. Runtime O(n)
+//
+// The order of the keys is the bucket order. The MultiMap must not be modified while iterating.
+func (mm *MultiMap[K, V]) Groups() iter.Seq2[K, []V] {
+	return func(yield func(K, []V) bool) {
+		for k, group := range mm.groups.All() {
+			if !yield(k, values(group)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over all key value pairs, with one pair per value. Runtime O(n)
+//
+// The values of a key follow each other. The MultiMap must not be modified while iterating.
+func (mm *MultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, vals := range mm.Groups() {
+			for _, v := range vals {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Clear MultiMap, resetting it to a newly initialized state. Runtime O(1)
+func (mm *MultiMap[K, V]) Clear() {
+	mm.groups.Clear()
+	mm.size = 0
+}
+
+// Hasher returns the Hasher used for the keys.
+func (mm *MultiMap[K, V]) Hasher() hashMap.Hasher[K] {
+	return mm.groups.Hasher()
+}
+
+// values returns the values of group in the order they were put.
+func values[K, V any](group *doublyLinkedListHM.LinkedList[K, V]) []V {
+	vals := make([]V, 0, group.Size)
+	for node := group.Head; node != nil; node = node.Next {
+		vals = append(vals, node.Value)
+	}
+	slices.Reverse(vals)
+	return vals
+}
+
+// newGroup creates the empty list of values of a new key.
+func newGroup[K, V any]() *doublyLinkedListHM.LinkedList[K, V] {
+	return &doublyLinkedListHM.LinkedList[K, V]{}
+}
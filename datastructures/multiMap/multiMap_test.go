@@ -0,0 +1,191 @@
+package multiMap
+
+import (
+	"dsa/datastructures/hashMap"
+	"dsa/util/sugar"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+type pair struct {
+	key string
+	val int
+}
+
+// filledMM puts every pair into a new MultiMap.
+func filledMM(pairs ...pair) *MultiMap[string, int] {
+	mm := NewMultiMap[string, int](0)
+	for _, p := range pairs {
+		_ = mm.Put(p.key, p.val)
+	}
+	return mm
+}
+
+func TestMultiMap_PutGetAll(t *testing.T) {
+	type testCase struct {
+		name     string
+		mm       *MultiMap[string, int]
+		key      string
+		want     []int
+		wantLen  uint
+		wantKeys uint
+	}
+	tests := []testCase{
+		{"empty map", filledMM(), "a", nil, 0, 0},
+		{"single value", filledMM(pair{"a", 1}), "a", []int{1}, 1, 1},
+		{"values in put order", filledMM(pair{"a", 3}, pair{"b", 9}, pair{"a", 1}, pair{"a", 2}), "a", []int{3, 1, 2}, 4, 2},
+		{"duplicate values", filledMM(pair{"a", 1}, pair{"a", 1}), "a", []int{1, 1}, 2, 1},
+		{"missing key", filledMM(pair{"a", 1}), "b", nil, 1, 1},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			got, err := tt.mm.GetAll(tt.key)
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetAll() = %v, %v, want %v, nil", got, err, tt.want)
+			}
+			if count, _ := tt.mm.Count(tt.key); count != uint(len(tt.want)) {
+				t.Errorf("Count() = %v, want %v", count, len(tt.want))
+			}
+			if found, _ := tt.mm.ContainsKey(tt.key); found != (tt.want != nil) {
+				t.Errorf("ContainsKey() = %v, want %v", found, tt.want != nil)
+			}
+			if tt.mm.Len() != tt.wantLen || tt.mm.KeyCount() != tt.wantKeys {
+				t.Errorf("Len(), KeyCount() = %v, %v, want %v, %v", tt.mm.Len(), tt.mm.KeyCount(), tt.wantLen, tt.wantKeys)
+			}
+		})
+	}
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		mm := NewMultiMap[func(), int](0)
+		if err := mm.Put(func() {}, 1); err == nil {
+			t.Errorf("Put() expected error for unhashable key")
+		}
+		if mm.Len() != 0 {
+			t.Errorf("Len() = %v, want 0", mm.Len())
+		}
+	})
+}
+
+func TestMultiMap_PutExistingKey(t *testing.T) {
+	name := "put to an existing key only allocates the value node"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		mm := NewMultiMap[int, int](0, hashMap.WithHasher[int](hashMap.ComparableHasher[int]{}))
+		_ = mm.Put(1, 1)
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = mm.Put(1, 2)
+		})
+		if allocs != 1 {
+			t.Errorf("Put() of an existing key allocated %v times, want 1", allocs)
+		}
+		if count, _ := mm.Count(1); count != 102 {
+			t.Errorf("Count() = %v, want %v", count, 102)
+		}
+	})
+}
+
+func TestMultiMap_RemoveOne(t *testing.T) {
+	type testCase struct {
+		name      string
+		mm        *MultiMap[string, int]
+		key       string
+		val       int
+		wantFound bool
+		want      []int
+		wantLen   uint
+	}
+	tests := []testCase{
+		{"missing key", filledMM(pair{"a", 1}), "b", 1, false, nil, 1},
+		{"missing value", filledMM(pair{"a", 1}), "a", 2, false, []int{1}, 1},
+		{"first value", filledMM(pair{"a", 1}, pair{"a", 2}, pair{"a", 3}), "a", 1, true, []int{2, 3}, 2},
+		{"middle value", filledMM(pair{"a", 1}, pair{"a", 2}, pair{"a", 3}), "a", 2, true, []int{1, 3}, 2},
+		{"last value", filledMM(pair{"a", 1}, pair{"a", 2}, pair{"a", 3}), "a", 3, true, []int{1, 2}, 2},
+		{"one of duplicates", filledMM(pair{"a", 1}, pair{"a", 2}, pair{"a", 1}), "a", 1, true, []int{1, 2}, 2},
+		{"only value removes key", filledMM(pair{"a", 1}, pair{"b", 2}), "a", 1, true, nil, 1},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			if found, err := tt.mm.RemoveOne(tt.key, tt.val); found != tt.wantFound || err != nil {
+				t.Errorf("RemoveOne() = %v, %v, want %v, nil", found, err, tt.wantFound)
+			}
+			if got, _ := tt.mm.GetAll(tt.key); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetAll() = %v, want %v", got, tt.want)
+			}
+			if found, _ := tt.mm.ContainsKey(tt.key); found != (tt.want != nil) {
+				t.Errorf("ContainsKey() = %v, want %v", found, tt.want != nil)
+			}
+			if tt.mm.Len() != tt.wantLen {
+				t.Errorf("Len() = %v, want %v", tt.mm.Len(), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestMultiMap_RemoveAll(t *testing.T) {
+	type testCase struct {
+		name        string
+		mm          *MultiMap[string, int]
+		key         string
+		wantRemoved uint
+		wantLen     uint
+	}
+	tests := []testCase{
+		{"missing key", filledMM(pair{"a", 1}), "b", 0, 1},
+		{"all values", filledMM(pair{"a", 1}, pair{"b", 2}, pair{"a", 3}), "a", 2, 1},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			if removed, err := tt.mm.RemoveAll(tt.key); removed != tt.wantRemoved || err != nil {
+				t.Errorf("RemoveAll() = %v, %v, want %v, nil", removed, err, tt.wantRemoved)
+			}
+			if found, _ := tt.mm.ContainsKey(tt.key); found {
+				t.Errorf("ContainsKey() found removed key")
+			}
+			if tt.mm.Len() != tt.wantLen {
+				t.Errorf("Len() = %v, want %v", tt.mm.Len(), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestMultiMap_Iteration(t *testing.T) {
+	name := "groups and pairs"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		mm := filledMM(pair{"a", 1}, pair{"b", 2}, pair{"a", 3}, pair{"c", 4}, pair{"b", 5})
+		want := map[string][]int{"a": {1, 3}, "b": {2, 5}, "c": {4}}
+
+		if got := maps.Collect(mm.Groups()); !reflect.DeepEqual(got, want) {
+			t.Errorf("Groups() = %v, want %v", got, want)
+		}
+		gotPairs := make(map[string][]int)
+		for k, v := range mm.All() {
+			gotPairs[k] = append(gotPairs[k], v)
+		}
+		if !reflect.DeepEqual(gotPairs, want) {
+			t.Errorf("All() = %v, want %v", gotPairs, want)
+		}
+		if keys := slices.Sorted(slices.Values(mm.Keys())); !slices.Equal(keys, []string{"a", "b", "c"}) {
+			t.Errorf("Keys() = %v, want %v", keys, []string{"a", "b", "c"})
+		}
+		for range mm.All() {
+			break
+		}
+
+		mm.Clear()
+		if !mm.IsEmpty() || mm.KeyCount() != 0 || len(mm.Keys()) != 0 {
+			t.Errorf("Clear() did not empty the map")
+		}
+	})
+}
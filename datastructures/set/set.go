@@ -0,0 +1,199 @@
+package set
+
+import (
+	"dsa/datastructures/hashMap"
+	"iter"
+)
+
+/*
+Set of unique elements, backed by a hashMap.HashMap with empty struct values, which take no memory.
+
+The hashing configuration (the Hasher) belongs to the underlying HashMap. Sets created by Union, Intersection, etc.
+use the Hasher of the receiver, so e.g. a case-insensitive set stays case-insensitive.
+Whether an element is in the other set is still decided by the Hasher of the other set.
+*/
+
+type Set[T any] struct {
+	hm *hashMap.HashMap[T, struct{}]
+}
+
+// NewSet creates a new empty Set with a random seed. Runtime O(n)
+//
+// initialCapacity - The initial capacity of the Set on its creation.
+//
+// opts - Optional configuration of the underlying HashMap, e.g. hashMap.WithHasher to replace the default Murmur3Hasher.
+func NewSet[T any](initialCapacity uint, opts ...hashMap.Option[T]) *Set[T] {
+	return &Set[T]{hm: hashMap.NewHashMap[T, struct{}](initialCapacity, opts...)}
+}
+
+// NewComparableSet creates a new empty Set for comparable elements, which uses the faster hashMap.ComparableHasher. Runtime O(n)
+func NewComparableSet[T comparable](initialCapacity uint) *Set[T] {
+	return NewSet[T](initialCapacity, hashMap.WithHasher[T](hashMap.ComparableHasher[T]{}))
+}
+
+// Collect creates a new Set from all elements of seq. Runtime O(n)
+//
+// Returns the Set collected so far and the error if an element could not be hashed.
+func Collect[T any](seq iter.Seq[T], opts ...hashMap.Option[T]) (*Set[T], error) {
+	s := NewSet[T](0, opts...)
+	for elem := range seq {
+		if _, err := s.Add(elem); err != nil {
+			return s, err
+		}
+	}
+	return s, nil
+}
+
+// Add elem to the Set. Runtime average case O(1), worst case O(n) when upsizing.
+//
+// Returns whether elem was added, i.e. was not in the Set yet.
+func (s *Set[T]) Add(elem T) (added bool, err error) {
+	return s.hm.InsertIfAbsent(elem, struct{}{})
+}
+
+// Remove elem from the Set. Runtime average case: O(1), worst case O(n) when downsizing.
+//
+// Returns whether elem was removed, i.e. was in the Set.
+func (s *Set[T]) Remove(elem T) (removed bool, err error) {
+	v, err := s.hm.Remove(elem)
+	return v != nil, err
+}
+
+// Contains - Check if elem is in the Set. Runtime O(1)
+func (s *Set[T]) Contains(elem T) (bool, error) {
+	return s.hm.ContainsKey(elem)
+}
+
+// Len returns the number of elements. Runtime O(1)
+func (s *Set[T]) Len() uint {
+	return s.hm.Size
+}
+
+// IsEmpty - Check if Set is empty. Runtime O(1)
+func (s *Set[T]) IsEmpty() bool {
+	return s.hm.IsEmpty()
+}
+
+// All returns an iterator over all elements. Runtime O(n)
+//
+// The order is the bucket order of the underlying HashMap. The Set must not be modified while iterating.
+func (s *Set[T]) All() iter.Seq[T] {
+	return s.hm.KeysSeq()
+}
+
+// Elems returns an array of all elements. Runtime O(n)
+func (s *Set[T]) Elems() []T {
+	return s.hm.Keys()
+}
+
+// Clear Set, resetting it to a newly initialized state. Runtime O(1)
+func (s *Set[T]) Clear() {
+	s.hm.Clear()
+}
+
+// Hasher returns the Hasher of the underlying HashMap.
+func (s *Set[T]) Hasher() hashMap.Hasher[T] {
+	return s.hm.Hasher()
+}
+
+// Union returns a new Set with all elements which are in s or other. Runtime O(n+m)
+func (s *Set[T]) Union(other *Set[T]) (*Set[T], error) {
+	result := s.empty(s.Len() + other.Len())
+	for _, from := range []*Set[T]{s, other} {
+		for elem := range from.All() {
+			if _, err := result.Add(elem); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// Intersection returns a new Set with all elements which are in s and other. Runtime O(n)
+func (s *Set[T]) Intersection(other *Set[T]) (*Set[T], error) {
+	result := s.empty(min(s.Len(), other.Len()))
+	for elem := range s.All() {
+		found, err := other.Contains(elem)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			if _, err := result.Add(elem); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// Difference returns a new Set with all elements of s which are not in other. Runtime O(n)
+func (s *Set[T]) Difference(other *Set[T]) (*Set[T], error) {
+	result := s.empty(s.Len())
+	if err := result.addMissing(s, other); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SymmetricDifference returns a new Set with all elements which are in either s or other, but not in both. Runtime O(n+m)
+func (s *Set[T]) SymmetricDifference(other *Set[T]) (*Set[T], error) {
+	result := s.empty(s.Len() + other.Len())
+	if err := result.addMissing(s, other); err != nil {
+		return nil, err
+	}
+	if err := result.addMissing(other, s); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IsSubset - Check if every element of s is in other. Runtime O(n)
+func (s *Set[T]) IsSubset(other *Set[T]) (bool, error) {
+	if s.Len() > other.Len() {
+		return false, nil
+	}
+	for elem := range s.All() {
+		found, err := other.Contains(elem)
+		if err != nil || !found {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// IsSuperset - Check if every element of other is in s. Runtime O(m)
+func (s *Set[T]) IsSuperset(other *Set[T]) (bool, error) {
+	return other.IsSubset(s)
+}
+
+// Equal - Check if s and other contain the same elements. Runtime O(n)
+func (s *Set[T]) Equal(other *Set[T]) (bool, error) {
+	if s.Len() != other.Len() {
+		return false, nil
+	}
+	return s.IsSubset(other)
+}
+
+// empty returns a new empty Set with the Hasher of s and room for capacity elements.
+func (s *Set[T]) empty(capacity uint) *Set[T] {
+	result := NewSet[T](0, hashMap.WithHasher[T](s.Hasher()))
+	result.hm.Reserve(capacity)
+	return result
+}
+
+// addMissing adds every element of from which is not in not.
+func (s *Set[T]) addMissing(from, not *Set[T]) error {
+	for elem := range from.All() {
+		found, err := not.Contains(elem)
+		if err != nil {
+			return err
+		}
+		if found {
+			continue
+		}
+		if _, err := s.Add(elem); err != nil {
+			return err
+		}
+	}
+	return nil
+}
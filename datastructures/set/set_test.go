@@ -0,0 +1,190 @@
+package set
+
+import (
+	"dsa/datastructures/hashMap"
+	"dsa/util/sugar"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// of creates a comparable Set with elems.
+func of(elems ...int) *Set[int] {
+	s := NewComparableSet[int](0)
+	for _, e := range elems {
+		_, _ = s.Add(e)
+	}
+	return s
+}
+
+// sorted returns the elements of s in ascending order.
+func sorted(s *Set[int]) []int {
+	elems := s.Elems()
+	slices.Sort(elems)
+	return elems
+}
+
+func TestSet_AddRemoveContains(t *testing.T) {
+	type testCase struct {
+		name        string
+		set         *Set[int]
+		elem        int
+		wantAdded   bool
+		wantRemoved bool
+	}
+	tests := []testCase{
+		{"add to empty set", of(), 1, true, true},
+		{"add new element", of(1, 2), 3, true, true},
+		{"add existing element", of(1, 2), 2, false, true},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			lenBefore := tt.set.Len()
+			if added, err := tt.set.Add(tt.elem); added != tt.wantAdded || err != nil {
+				t.Errorf("Add() = %v, %v, want %v, nil", added, err, tt.wantAdded)
+			}
+			if found, _ := tt.set.Contains(tt.elem); !found {
+				t.Errorf("Contains() did not find added element")
+			}
+			if tt.wantAdded && tt.set.Len() != lenBefore+1 {
+				t.Errorf("Len() = %v, want %v", tt.set.Len(), lenBefore+1)
+			}
+			if removed, err := tt.set.Remove(tt.elem); removed != tt.wantRemoved || err != nil {
+				t.Errorf("Remove() = %v, %v, want %v, nil", removed, err, tt.wantRemoved)
+			}
+			if removed, _ := tt.set.Remove(tt.elem); removed {
+				t.Errorf("Remove() removed element twice")
+			}
+			if found, _ := tt.set.Contains(tt.elem); found {
+				t.Errorf("Contains() found removed element")
+			}
+		})
+	}
+	println()
+	t.Run("test error", func(t *testing.T) {
+		defer sugar.Lite(t, "test error")
+		s := NewSet[func()](0)
+		if _, err := s.Add(func() {}); err == nil {
+			t.Errorf("Add() expected error for unhashable element")
+		}
+	})
+}
+
+func TestSet_Operations(t *testing.T) {
+	type testCase struct {
+		name     string
+		a, b     *Set[int]
+		union    []int
+		inter    []int
+		diff     []int
+		symDiff  []int
+		subset   bool
+		superset bool
+		equal    bool
+	}
+	tests := []testCase{
+		{"both empty", of(), of(), []int{}, []int{}, []int{}, []int{}, true, true, true},
+		{"empty and filled", of(), of(1, 2), []int{1, 2}, []int{}, []int{}, []int{1, 2}, true, false, false},
+		{"filled and empty", of(1, 2), of(), []int{1, 2}, []int{}, []int{1, 2}, []int{1, 2}, false, true, false},
+		{"overlapping", of(1, 2, 3), of(2, 3, 4), []int{1, 2, 3, 4}, []int{2, 3}, []int{1}, []int{1, 4}, false, false, false},
+		{"disjoint", of(1, 2), of(3, 4), []int{1, 2, 3, 4}, []int{}, []int{1, 2}, []int{1, 2, 3, 4}, false, false, false},
+		{"proper subset", of(1, 2), of(1, 2, 3), []int{1, 2, 3}, []int{1, 2}, []int{}, []int{3}, true, false, false},
+		{"equal", of(1, 2, 3), of(3, 2, 1), []int{1, 2, 3}, []int{1, 2, 3}, []int{}, []int{}, true, true, true},
+	}
+	for _, tt := range tests {
+		println()
+		t.Run(tt.name, func(t *testing.T) {
+			defer sugar.Lite(t, tt.name)
+			union, _ := tt.a.Union(tt.b)
+			if got := sorted(union); !slices.Equal(got, tt.union) {
+				t.Errorf("Union() = %v, want %v", got, tt.union)
+			}
+			inter, _ := tt.a.Intersection(tt.b)
+			if got := sorted(inter); !slices.Equal(got, tt.inter) {
+				t.Errorf("Intersection() = %v, want %v", got, tt.inter)
+			}
+			diff, _ := tt.a.Difference(tt.b)
+			if got := sorted(diff); !slices.Equal(got, tt.diff) {
+				t.Errorf("Difference() = %v, want %v", got, tt.diff)
+			}
+			symDiff, _ := tt.a.SymmetricDifference(tt.b)
+			if got := sorted(symDiff); !slices.Equal(got, tt.symDiff) {
+				t.Errorf("SymmetricDifference() = %v, want %v", got, tt.symDiff)
+			}
+			if got, _ := tt.a.IsSubset(tt.b); got != tt.subset {
+				t.Errorf("IsSubset() = %v, want %v", got, tt.subset)
+			}
+			if got, _ := tt.a.IsSuperset(tt.b); got != tt.superset {
+				t.Errorf("IsSuperset() = %v, want %v", got, tt.superset)
+			}
+			if got, _ := tt.a.Equal(tt.b); got != tt.equal {
+				t.Errorf("Equal() = %v, want %v", got, tt.equal)
+			}
+			// The operations must not change their operands.
+			if tt.a.Len()+tt.b.Len() != uint(len(tt.inter)+len(tt.union)) {
+				t.Errorf("operands changed: |a| + |b| = %v, want |a ∩ b| + |a ∪ b| = %v", tt.a.Len()+tt.b.Len(), len(tt.inter)+len(tt.union))
+			}
+		})
+	}
+}
+
+type caseInsensitiveHasher struct{}
+
+func (caseInsensitiveHasher) Hash(key string, seed uint32) (uint32, error) {
+	return hashMap.ComparableHasher[string]{}.Hash(strings.ToLower(key), seed)
+}
+
+func (caseInsensitiveHasher) Equal(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+func TestSet_SharedHasher(t *testing.T) {
+	name := "operations keep the hasher"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		opt := hashMap.WithHasher[string](caseInsensitiveHasher{})
+		a, _ := Collect(slices.Values([]string{"Go", "Rust"}), opt)
+		b, _ := Collect(slices.Values([]string{"GO", "zig"}), opt)
+
+		if added, _ := a.Add("rust"); added {
+			t.Errorf("Add() added element which differs only in case")
+		}
+		union, _ := a.Union(b)
+		if union.Len() != 3 {
+			t.Errorf("Union() = %v, want 3 elements", union.Elems())
+		}
+		if _, ok := union.Hasher().(caseInsensitiveHasher); !ok {
+			t.Errorf("Union() has Hasher %T, want caseInsensitiveHasher", union.Hasher())
+		}
+		if found, _ := union.Contains("ZIG"); !found {
+			t.Errorf("Union().Contains() is not case-insensitive")
+		}
+		inter, _ := a.Intersection(b)
+		if inter.Len() != 1 {
+			t.Errorf("Intersection() = %v, want 1 element", inter.Elems())
+		}
+	})
+}
+
+func TestSet_AllClear(t *testing.T) {
+	name := "iterate and clear"
+	println()
+	t.Run(name, func(t *testing.T) {
+		defer sugar.Lite(t, name)
+		s := of(3, 1, 2)
+		got := slices.Sorted(s.All())
+		if !slices.Equal(got, []int{1, 2, 3}) {
+			t.Errorf("All() = %v, want %v", got, []int{1, 2, 3})
+		}
+		for range s.All() {
+			break
+		}
+		s.Clear()
+		if !s.IsEmpty() || s.Len() != 0 || len(s.Elems()) != 0 {
+			t.Errorf("Clear() did not empty the set")
+		}
+	})
+}
@@ -24,7 +24,7 @@ func goMap() {
 }
 
 func myMap() {
-	hm := hashMap.NewHashMap[int, int](0)
+	hm := hashMap.NewComparableHashMap[int, int](0)
 
 	defer perf.MeasurePerformance()
 	defer perf.TimeTracker(time.Now(), "my map")
@@ -34,28 +34,5 @@ func myMap() {
 		elements--
 	}
 
-	nilCount := 0
-	for _, v := range hm.Pairs {
-		if v == nil {
-			nilCount++
-		}
-	}
-
-	println(nilCount)
-
-	longestLL := 0
-	for _, v := range hm.Pairs {
-		if v != nil {
-			node := v.Head
-			l := 0
-			for node != nil {
-				l++
-				node = node.Next
-			}
-			if l > longestLL {
-				longestLL = l
-			}
-		}
-	}
-	println(longestLL)
+	hm.Stats().Print("my map")
 }